@@ -0,0 +1,85 @@
+package excelmetadata
+
+import (
+	"runtime"
+	"sync"
+)
+
+// BatchResult is the outcome of extracting one workbook of a batch
+type BatchResult struct {
+	Path     string
+	Metadata *Metadata
+	Err      error
+}
+
+// BatchExtract extracts every workbook in paths using at most workers
+// goroutines (runtime.NumCPU when workers <= 0). A failing workbook does
+// not stop the batch; its error is reported in its result. Results are
+// returned in the order of paths.
+func BatchExtract(paths []string, options *Options, workers int) []BatchResult {
+	results := make([]BatchResult, len(paths))
+	index := make(map[string][]int, len(paths))
+	for i, path := range paths {
+		index[path] = append(index[path], i)
+	}
+
+	BatchExtractFunc(paths, options, workers, func(result BatchResult) {
+		i := index[result.Path][0]
+		index[result.Path] = index[result.Path][1:]
+		results[i] = result
+	})
+	return results
+}
+
+// BatchExtractFunc is like BatchExtract but hands every result to fn as
+// soon as its workbook is done, so callers can report progress or write
+// output without holding the whole batch in memory. Calls to fn are
+// serialised and happen in completion order.
+func BatchExtractFunc(paths []string, options *Options, workers int, fn func(BatchResult)) {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if workers > len(paths) {
+		workers = len(paths)
+	}
+
+	jobs := make(chan string)
+	results := make(chan BatchResult)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for path := range jobs {
+				metadata, err := extractFile(path, options)
+				results <- BatchResult{Path: path, Metadata: metadata, Err: err}
+			}
+		}()
+	}
+
+	go func() {
+		for _, path := range paths {
+			jobs <- path
+		}
+		close(jobs)
+		wg.Wait()
+		close(results)
+	}()
+
+	for result := range results {
+		fn(result)
+	}
+}
+
+func extractFile(path string, options *Options) (*Metadata, error) {
+	extractor, err := New(path, options)
+	if err != nil {
+		return nil, err
+	}
+	defer func(extractor *Extractor) {
+		_ = extractor.Close()
+	}(extractor)
+
+	return extractor.Extract()
+}
@@ -0,0 +1,90 @@
+package excelmetadata_test
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/prongbang/excelmetadata"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestBatchExtract(t *testing.T) {
+	var paths []string
+	for _, value := range []string{"first", "second", "third", "fourth"} {
+		paths = append(paths, newWorkbook(t, func(f *excelize.File) {
+			_ = f.SetCellValue("Sheet1", "A1", value)
+		}))
+	}
+	broken := filepath.Join(t.TempDir(), "broken.xlsx")
+	if err := os.WriteFile(broken, []byte("not a workbook"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	paths = append(paths[:2], append([]string{broken}, paths[2:]...)...)
+
+	results := excelmetadata.BatchExtract(paths, nil, 2)
+	if len(results) != len(paths) {
+		t.Fatalf("got %d results, want %d", len(results), len(paths))
+	}
+
+	want := []string{"first", "second", "", "third", "fourth"}
+	for i, result := range results {
+		if result.Path != paths[i] {
+			t.Errorf("result %d path = %s, want %s", i, result.Path, paths[i])
+		}
+		if paths[i] == broken {
+			if result.Err == nil || result.Metadata != nil {
+				t.Errorf("broken workbook: err = %v, metadata = %v", result.Err, result.Metadata)
+			}
+			continue
+		}
+		if result.Err != nil {
+			t.Errorf("%s: %v", result.Path, result.Err)
+			continue
+		}
+		if got := result.Metadata.Sheets[0].Cells[0].Value; got != want[i] {
+			t.Errorf("result %d A1 = %v, want %s", i, got, want[i])
+		}
+	}
+}
+
+func TestBatchExtractFunc(t *testing.T) {
+	var paths []string
+	for i := 0; i < 6; i++ {
+		paths = append(paths, newWorkbook(t, func(f *excelize.File) {
+			_ = f.SetCellValue("Sheet1", "A1", i)
+		}))
+	}
+
+	var (
+		mu   sync.Mutex
+		seen []string
+	)
+	excelmetadata.BatchExtractFunc(paths, nil, 0, func(result excelmetadata.BatchResult) {
+		mu.Lock()
+		defer mu.Unlock()
+		if result.Err != nil {
+			t.Errorf("%s: %v", result.Path, result.Err)
+		}
+		seen = append(seen, result.Path)
+	})
+
+	sort.Strings(seen)
+	sorted := append([]string(nil), paths...)
+	sort.Strings(sorted)
+	if len(seen) != len(sorted) {
+		t.Fatalf("callback ran %d times, want %d", len(seen), len(sorted))
+	}
+	for i := range sorted {
+		if seen[i] != sorted[i] {
+			t.Errorf("callback paths = %v, want %v", seen, sorted)
+			break
+		}
+	}
+
+	excelmetadata.BatchExtractFunc(nil, nil, 4, func(excelmetadata.BatchResult) {
+		t.Error("callback should not run for an empty batch")
+	})
+}
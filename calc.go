@@ -0,0 +1,154 @@
+package excelmetadata
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// calcTolerance is the relative difference below which a recalculated
+// number matches its cached value
+const calcTolerance = 1e-9
+
+// CalculationReport compares the cached results of formulas with the values
+// excelize calculates for them
+type CalculationReport struct {
+	// CalcMode is the calculation mode of the workbook: auto, autoNoTable or
+	// manual. Stale values are typical for manual calculation.
+	CalcMode string `json:"calcMode"`
+	// FullCalcOnLoad is set when Excel recalculates the workbook on open
+	FullCalcOnLoad bool `json:"fullCalcOnLoad,omitempty"`
+	// Checked is the number of formulas that were recalculated
+	Checked int `json:"checked"`
+	// Stale lists formulas whose cached value differs from the result
+	Stale []CalcIssue `json:"stale,omitempty"`
+	// Unevaluable lists formulas excelize cannot calculate, for example
+	// because they use an unsupported function
+	Unevaluable []CalcIssue `json:"unevaluable,omitempty"`
+}
+
+// CalcIssue is a formula reported by a calculation check
+type CalcIssue struct {
+	Sheet      string `json:"sheet"`
+	Cell       string `json:"cell"`
+	Formula    string `json:"formula"`
+	Cached     string `json:"cached"`
+	Calculated string `json:"calculated,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// VerifyCalculation recalculates every formula of the selected sheets and
+// reports the formulas whose cached value is stale or cannot be calculated
+func (e *Extractor) VerifyCalculation() (*CalculationReport, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	report := &CalculationReport{CalcMode: "auto"}
+	props, err := e.file.GetCalcProps()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read calculation properties: %w", err)
+	}
+	if props.CalcMode != nil && *props.CalcMode != "" {
+		report.CalcMode = *props.CalcMode
+	}
+	if props.FullCalcOnLoad != nil {
+		report.FullCalcOnLoad = *props.FullCalcOnLoad
+	}
+
+	sheets, _ := e.selectedSheets()
+	for _, sheetName := range sheets {
+		// GetSheetDimension loads sheets kept in temporary files into the
+		// package before its parts are read
+		_, _ = e.file.GetSheetDimension(sheetName)
+		bounds := e.selection.rangeOf(sheetName)
+		formulas := e.sheetFormulas(sheetName)
+		for _, cell := range sortedCells(formulas) {
+			if !bounds.containsCell(cell) {
+				continue
+			}
+			info := formulas[cell]
+			formula, _ := e.file.GetCellFormula(sheetName, cell)
+			issue := CalcIssue{Sheet: sheetName, Cell: cell, Formula: formula, Cached: info.CachedValue}
+			report.Checked++
+
+			if info.Kind == FormulaDataTable {
+				issue.Error = "data table formulas are not calculated"
+				report.Unevaluable = append(report.Unevaluable, issue)
+				continue
+			}
+			result, err := e.file.CalcCellValue(sheetName, cell, excelize.Options{RawCellValue: true})
+			if err != nil {
+				// Formulas evaluating to an error value return it as the
+				// error, anything else means excelize could not evaluate
+				// the formula
+				if !strings.HasPrefix(err.Error(), "#") {
+					issue.Error = err.Error()
+					report.Unevaluable = append(report.Unevaluable, issue)
+					continue
+				}
+				result = err.Error()
+			}
+			if !cachedValueMatches(info, result) {
+				issue.Calculated = result
+				report.Stale = append(report.Stale, issue)
+			}
+		}
+	}
+	return report, nil
+}
+
+// cachedValueMatches reports whether a recalculated result equals the cached
+// value of a formula
+func cachedValueMatches(info *FormulaInfo, result string) bool {
+	cached := info.CachedValue
+	if cached == result {
+		return true
+	}
+	switch strings.ToUpper(result) {
+	case "TRUE":
+		return cached == "1" || strings.EqualFold(cached, "TRUE")
+	case "FALSE":
+		return cached == "0" || strings.EqualFold(cached, "FALSE")
+	case "":
+		// A reference to an empty cell calculates to 0
+		return cached == "0"
+	}
+
+	if info.CachedType == "e" || info.CachedType == "s" || info.CachedType == "inlineStr" {
+		return false
+	}
+	a, errA := strconv.ParseFloat(cached, 64)
+	b, errB := strconv.ParseFloat(result, 64)
+	if errA != nil || errB != nil {
+		return false
+	}
+	return math.Abs(a-b) <= calcTolerance*math.Max(1, math.Max(math.Abs(a), math.Abs(b)))
+}
+
+// sortedCells returns the addresses of a cell map in row-major order
+func sortedCells[T any](cells map[string]T) []string {
+	type position struct {
+		cell     string
+		col, row int
+	}
+	positions := make([]position, 0, len(cells))
+	for cell := range cells {
+		col, row, _ := excelize.CellNameToCoordinates(cell)
+		positions = append(positions, position{cell, col, row})
+	}
+	sort.Slice(positions, func(i, j int) bool {
+		if positions[i].row != positions[j].row {
+			return positions[i].row < positions[j].row
+		}
+		return positions[i].col < positions[j].col
+	})
+	addresses := make([]string, len(positions))
+	for i, p := range positions {
+		addresses[i] = p.cell
+	}
+	return addresses
+}
@@ -0,0 +1,74 @@
+package excelmetadata_test
+
+import (
+	"testing"
+
+	"github.com/prongbang/excelmetadata"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestVerifyCalculation(t *testing.T) {
+	filename := newWorkbook(t, func(f *excelize.File) {
+		formula := func(cell string, cached interface{}, formula string) {
+			_ = f.SetCellValue("Sheet1", cell, cached)
+			_ = f.SetCellFormula("Sheet1", cell, formula)
+		}
+		_ = f.SetCellValue("Sheet1", "A1", 3)
+		_ = f.SetCellValue("Sheet1", "A2", 4)
+
+		formula("B1", 7, "A1+A2")
+		formula("B2", 0.42857142857142855, "A1/7")
+		formula("B3", true, "A1>1")
+		formula("B4", "a3", `"a"&A1`)
+		formula("B5", 10, "A1*A2")
+		formula("B6", 1, "WEBSERVICE(\"https://example.com\")")
+		formula("B7", 1, "1/0")
+
+		manual := "manual"
+		_ = f.SetCalcProps(&excelize.CalcPropsOptions{CalcMode: &manual})
+	})
+	// SetCellFormula keeps the shared string index of a text value as the
+	// cached result, and Excel stores error results with the error type
+	rewritePart(t, filename, "xl/worksheets/sheet1.xml",
+		`<c r="B4" t="str"><f>&#34;a&#34;&amp;A1</f><v>0</v>`, `<c r="B4" t="str"><f>&#34;a&#34;&amp;A1</f><v>a3</v>`)
+	rewritePart(t, filename, "xl/worksheets/sheet1.xml",
+		`<c r="B7" t="str"><f>1/0</f><v>1</v>`, `<c r="B7" t="e"><f>1/0</f><v>#DIV/0!</v>`)
+
+	extractor, err := excelmetadata.New(filename, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer extractor.Close()
+
+	report, err := extractor.VerifyCalculation()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.CalcMode != "manual" || report.Checked != 7 {
+		t.Errorf("calc mode = %s, checked = %d, want manual and 7", report.CalcMode, report.Checked)
+	}
+	if len(report.Stale) != 1 || report.Stale[0].Cell != "B5" || report.Stale[0].Cached != "10" || report.Stale[0].Calculated != "12" {
+		t.Errorf("stale = %+v, want B5 cached 10 calculated 12", report.Stale)
+	}
+	if len(report.Unevaluable) != 1 || report.Unevaluable[0].Cell != "B6" || report.Unevaluable[0].Error == "" {
+		t.Errorf("unevaluable = %+v, want B6", report.Unevaluable)
+	}
+}
+
+func TestExtractVerifyCalculation(t *testing.T) {
+	filename := newWorkbook(t, func(f *excelize.File) {
+		_ = f.SetCellValue("Sheet1", "A1", 0)
+		_ = f.SetCellValue("Sheet1", "B1", 1)
+		_ = f.SetCellFormula("Sheet1", "B1", "1/A1")
+	})
+
+	if metadata := extract(t, filename); metadata.Calculation != nil {
+		t.Errorf("calculation report without VerifyCalculation: %+v", metadata.Calculation)
+	}
+
+	metadata := extractWithOptions(t, filename, func(o *excelmetadata.Options) { o.VerifyCalculation = true })
+	report := metadata.Calculation
+	if report == nil || report.CalcMode != "auto" || len(report.Stale) != 1 || report.Stale[0].Calculated != "#DIV/0!" {
+		t.Errorf("calculation = %+v, want B1 stale with #DIV/0!", report)
+	}
+}
@@ -0,0 +1,233 @@
+package excelmetadata
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+)
+
+// Compound File Binary (OLE2) structures, enough to list the streams of
+// vbaProject.bin and to read the streams of embedded OLE objects
+
+var cfbSignature = []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}
+
+const (
+	cfbEndOfChain  = 0xFFFFFFFE
+	cfbNoStream    = 0xFFFFFFFF
+	cfbEntrySize   = 128
+	cfbStorage     = 1
+	cfbStream      = 2
+	cfbRoot        = 5
+	cfbMiniCutoff  = 4096
+	cfbMaxSectors  = 1 << 20
+	cfbMiniSectors = 64
+)
+
+// cfbEntry is a directory entry of a compound file
+type cfbEntry struct {
+	name               string
+	kind               byte
+	left, right, child uint32
+	start              uint32
+	size               uint64
+}
+
+// cfbFile is a parsed compound file
+type cfbFile struct {
+	data       []byte
+	sectorSize int
+	fat        []uint32
+	miniFAT    []uint32
+	entries    []cfbEntry
+	ministream []byte
+}
+
+// parseCFB reads the allocation tables and directory of a compound file
+func parseCFB(data []byte) (*cfbFile, error) {
+	if len(data) < 512 || !bytes.Equal(data[:8], cfbSignature) {
+		return nil, fmt.Errorf("not a compound file")
+	}
+	shift := binary.LittleEndian.Uint16(data[0x1E:])
+	if shift != 9 && shift != 12 {
+		return nil, fmt.Errorf("invalid sector shift %d", shift)
+	}
+	c := &cfbFile{data: data, sectorSize: 1 << shift}
+
+	// The first 109 FAT sectors are listed in the header, the others in a
+	// chain of DIFAT sectors. The header count is not trusted beyond the
+	// number of sectors the file can hold.
+	numFAT := int(binary.LittleEndian.Uint32(data[0x2C:]))
+	if limit := len(data) / c.sectorSize; numFAT > limit {
+		numFAT = limit
+	}
+	var fatSectors []uint32
+	for i := 0; i < 109 && len(fatSectors) < numFAT; i++ {
+		fatSectors = append(fatSectors, binary.LittleEndian.Uint32(data[0x4C+4*i:]))
+	}
+	perSector := c.sectorSize / 4
+	seen := make(map[uint32]bool)
+	for difat := binary.LittleEndian.Uint32(data[0x44:]); difat < cfbEndOfChain && len(fatSectors) < numFAT; {
+		if seen[difat] || len(seen) > numFAT/(perSector-1) {
+			return nil, fmt.Errorf("DIFAT chain loops")
+		}
+		seen[difat] = true
+		sector := c.sector(difat)
+		if sector == nil {
+			return nil, fmt.Errorf("invalid DIFAT sector %d", difat)
+		}
+		for i := 0; i < perSector-1 && len(fatSectors) < numFAT; i++ {
+			fatSectors = append(fatSectors, binary.LittleEndian.Uint32(sector[4*i:]))
+		}
+		difat = binary.LittleEndian.Uint32(sector[c.sectorSize-4:])
+	}
+	for _, n := range fatSectors {
+		sector := c.sector(n)
+		if sector == nil {
+			return nil, fmt.Errorf("invalid FAT sector %d", n)
+		}
+		for i := 0; i < perSector; i++ {
+			c.fat = append(c.fat, binary.LittleEndian.Uint32(sector[4*i:]))
+		}
+	}
+
+	dir, err := c.chain(binary.LittleEndian.Uint32(data[0x30:]), c.fat, c.sectorSize, c.sector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid directory: %w", err)
+	}
+	for offset := 0; offset+cfbEntrySize <= len(dir); offset += cfbEntrySize {
+		raw := dir[offset : offset+cfbEntrySize]
+		nameLen := int(binary.LittleEndian.Uint16(raw[64:]))
+		if nameLen > 64 {
+			nameLen = 64
+		}
+		name := make([]uint16, 0, 32)
+		for i := 0; i+1 < nameLen; i += 2 {
+			if r := binary.LittleEndian.Uint16(raw[i:]); r != 0 {
+				name = append(name, r)
+			}
+		}
+		c.entries = append(c.entries, cfbEntry{
+			name:  string(utf16.Decode(name)),
+			kind:  raw[66],
+			left:  binary.LittleEndian.Uint32(raw[68:]),
+			right: binary.LittleEndian.Uint32(raw[72:]),
+			child: binary.LittleEndian.Uint32(raw[76:]),
+			start: binary.LittleEndian.Uint32(raw[116:]),
+			size:  binary.LittleEndian.Uint64(raw[120:]),
+		})
+	}
+	if len(c.entries) == 0 || c.entries[0].kind != cfbRoot {
+		return nil, fmt.Errorf("missing root entry")
+	}
+
+	// Streams below the cutoff live in the mini stream, which is the stream
+	// of the root entry, allocated by the mini FAT
+	if miniFAT, err := c.chain(binary.LittleEndian.Uint32(data[0x3C:]), c.fat, c.sectorSize, c.sector); err == nil {
+		for i := 0; i+4 <= len(miniFAT); i += 4 {
+			c.miniFAT = append(c.miniFAT, binary.LittleEndian.Uint32(miniFAT[i:]))
+		}
+	}
+	if root := c.entries[0]; root.start < cfbEndOfChain {
+		c.ministream, _ = c.chain(root.start, c.fat, c.sectorSize, c.sector)
+	}
+	return c, nil
+}
+
+// sector returns a regular sector, nil when out of range
+func (c *cfbFile) sector(n uint32) []byte {
+	offset := (int(n) + 1) * c.sectorSize
+	if n >= cfbMaxSectors || offset+c.sectorSize > len(c.data) {
+		return nil
+	}
+	return c.data[offset : offset+c.sectorSize]
+}
+
+// miniSector returns a sector of the mini stream, nil when out of range
+func (c *cfbFile) miniSector(n uint32) []byte {
+	offset := int(n) * cfbMiniSectors
+	if n >= cfbMaxSectors || offset+cfbMiniSectors > len(c.ministream) {
+		return nil
+	}
+	return c.ministream[offset : offset+cfbMiniSectors]
+}
+
+// chain concatenates the sectors of an allocation chain. A sector is read
+// at most once, so a chain is never longer than the file.
+func (c *cfbFile) chain(start uint32, table []uint32, size int, sector func(uint32) []byte) ([]byte, error) {
+	var buf []byte
+	visited := make([]bool, len(table))
+	for n := start; n < cfbEndOfChain; n = table[n] {
+		data := sector(n)
+		if data == nil || int(n) >= len(table) {
+			return nil, fmt.Errorf("invalid sector %d", n)
+		}
+		if visited[n] {
+			return nil, fmt.Errorf("sector chain loops")
+		}
+		visited[n] = true
+		buf = append(buf, data[:size]...)
+	}
+	return buf, nil
+}
+
+// children returns the entries of a storage, in directory tree order
+func (c *cfbFile) children(storage cfbEntry) []cfbEntry {
+	var (
+		entries []cfbEntry
+		seen    = make(map[uint32]bool)
+		walk    func(id uint32)
+	)
+	walk = func(id uint32) {
+		if id == cfbNoStream || int(id) >= len(c.entries) || seen[id] {
+			return
+		}
+		seen[id] = true
+		entry := c.entries[id]
+		walk(entry.left)
+		entries = append(entries, entry)
+		walk(entry.right)
+	}
+	walk(storage.child)
+	return entries
+}
+
+// find returns the entry at a slash separated path below the root, with
+// names compared case-insensitively
+func (c *cfbFile) find(path string) (cfbEntry, bool) {
+	entry := c.entries[0]
+	for _, name := range strings.Split(path, "/") {
+		found := false
+		for _, child := range c.children(entry) {
+			if strings.EqualFold(child.name, name) {
+				entry, found = child, true
+				break
+			}
+		}
+		if !found {
+			return cfbEntry{}, false
+		}
+	}
+	return entry, true
+}
+
+// stream returns the content of a stream entry
+func (c *cfbFile) stream(entry cfbEntry) ([]byte, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if entry.size < cfbMiniCutoff {
+		data, err = c.chain(entry.start, c.miniFAT, cfbMiniSectors, c.miniSector)
+	} else {
+		data, err = c.chain(entry.start, c.fat, c.sectorSize, c.sector)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(data)) < entry.size {
+		return nil, fmt.Errorf("stream %s is truncated", entry.name)
+	}
+	return data[:entry.size], nil
+}
@@ -0,0 +1,24 @@
+package excelmetadata
+
+import "testing"
+
+// FuzzParseCFB feeds malformed compound files to the parser, which reads
+// vbaProject.bin and embedded objects of untrusted workbooks. The seeds in
+// testdata/fuzz/FuzzParseCFB are a VBA project and an OLE package.
+func FuzzParseCFB(f *testing.F) {
+	f.Add([]byte{})
+	f.Add(append(append([]byte(nil), cfbSignature...), make([]byte, 504)...))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		c, err := parseCFB(data)
+		if err != nil {
+			return
+		}
+		for _, entry := range c.entries {
+			c.children(entry)
+			if stream, err := c.stream(entry); err == nil && uint64(len(stream)) != entry.size {
+				t.Errorf("stream %q has %d bytes, want %d", entry.name, len(stream), entry.size)
+			}
+		}
+		c.find("VBA/dir")
+	})
+}
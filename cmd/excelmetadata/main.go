@@ -1,15 +1,20 @@
 package main
 
 import (
+	"bytes"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"log"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/prongbang/excelmetadata"
 	"github.com/urfave/cli/v2"
+	_ "modernc.org/sqlite"
 )
 
 const version = "v1.0.3"
@@ -28,7 +33,7 @@ func main() {
 					&cli.StringFlag{
 						Name:    "output",
 						Aliases: []string{"o"},
-						Usage:   "Output JSON file path",
+						Usage:   "Output file path; the extension selects the format (see 'formats')",
 					},
 					&cli.BoolFlag{
 						Name:    "pretty",
@@ -41,6 +46,41 @@ func main() {
 						Usage:   "Maximum cells per sheet (0 for unlimited)",
 						Value:   0,
 					},
+					&cli.IntFlag{
+						Name:  "max-rows",
+						Usage: "Maximum rows with data per sheet (0 for unlimited)",
+					},
+					&cli.IntFlag{
+						Name:  "max-cols",
+						Usage: "Keep only the leftmost columns with data (0 for unlimited)",
+					},
+					&cli.StringFlag{
+						Name:  "sample",
+						Usage: "Rows and cells kept when a limit is exceeded: head, tail, stride or random",
+						Value: excelmetadata.SampleHead,
+					},
+					&cli.Int64Flag{
+						Name:  "seed",
+						Usage: "Seed of --sample random",
+					},
+					&cli.BoolFlag{
+						Name:  "parse-formulas",
+						Usage: "List the functions and references used by each formula",
+					},
+					&cli.BoolFlag{
+						Name:  "verify-calc",
+						Usage: "Recalculate formulas and report stale cached values",
+					},
+					&cli.BoolFlag{
+						Name:  "audit",
+						Usage: "Add a security report of macros, external links, DDE and OLE objects, hyperlinks and hidden sheets",
+					},
+					redactFlag(),
+					redactKeyFlag(),
+					&cli.BoolFlag{
+						Name:  "password-hash",
+						Usage: "Include the password hash of protected sheets",
+					},
 					&cli.BoolFlag{
 						Name:  "no-styles",
 						Usage: "Exclude styles from extraction",
@@ -49,9 +89,59 @@ func main() {
 						Name:  "no-images",
 						Usage: "Exclude images from extraction",
 					},
+					&cli.StringFlag{
+						Name:    "format",
+						Aliases: []string{"f"},
+						Usage:   "Output format, one of 'formats' (default: from output extension, json for stdout)",
+					},
+					&cli.BoolFlag{
+						Name:  "deterministic",
+						Usage: "Produce byte-identical output for the same workbook",
+					},
+					&cli.StringFlag{
+						Name:  "go-package",
+						Usage: "Package name of generated Go output",
+						Value: "main",
+					},
+					&cli.StringFlag{
+						Name:  "go-var",
+						Usage: "Variable name of generated Go output",
+						Value: "metadata",
+					},
+					&cli.StringFlag{
+						Name:  "dir",
+						Usage: "Extract every workbook below this directory into --output, mirroring the tree",
+					},
+					&cli.IntFlag{
+						Name:  "workers",
+						Usage: "Workbooks extracted concurrently with --dir (0 for one per CPU)",
+					},
+					&cli.StringSliceFlag{
+						Name:    "sheet",
+						Aliases: []string{"s"},
+						Usage:   "Only extract matching sheets: a name, a glob like 'Q*' or a /regexp/ (repeatable)",
+					},
+					&cli.StringSliceFlag{
+						Name:  "exclude-sheet",
+						Usage: "Skip matching sheets (repeatable)",
+					},
+					&cli.BoolFlag{
+						Name:  "skip-hidden",
+						Usage: "Skip hidden and very hidden sheets",
+					},
+					&cli.StringSliceFlag{
+						Name:    "range",
+						Aliases: []string{"r"},
+						Usage:   "Only extract a cell range of a sheet, e.g. 'Data!A1:F500' (repeatable, one per sheet)",
+					},
 				},
 				Action: handleExtract,
 			},
+			{
+				Name:   "formats",
+				Usage:  "List the available output formats",
+				Action: handleFormats,
+			},
 			{
 				Name:    "compare",
 				Aliases: []string{"c"},
@@ -66,10 +156,13 @@ func main() {
 				Action: handleCompare,
 			},
 			{
-				Name:    "search",
-				Aliases: []string{"s"},
-				Usage:   "Search Excel files in directory",
+				Name:      "search",
+				Aliases:   []string{"s"},
+				Usage:     "Search values, formulas, comments, names, hyperlinks and properties of Excel files",
+				ArgsUsage: "<dir|file>...",
 				Flags: []cli.Flag{
+					redactFlag(),
+					redactKeyFlag(),
 					&cli.StringFlag{
 						Name:    "pattern",
 						Aliases: []string{"p"},
@@ -80,32 +173,399 @@ func main() {
 						Aliases: []string{"r"},
 						Usage:   "Search in subdirectories",
 					},
+					&cli.BoolFlag{
+						Name:    "regex",
+						Aliases: []string{"E"},
+						Usage:   "Treat the pattern as a regular expression",
+					},
+					&cli.BoolFlag{
+						Name:    "ignore-case",
+						Aliases: []string{"i"},
+						Usage:   "Match without regard to case",
+					},
+					&cli.BoolFlag{
+						Name:    "word",
+						Aliases: []string{"w"},
+						Usage:   "Match whole words only",
+					},
+					&cli.StringSliceFlag{
+						Name:    "target",
+						Aliases: []string{"t"},
+						Usage:   "Restrict to values, formulas, comments, names, hyperlinks, properties or sheets (repeatable)",
+					},
+					&cli.IntFlag{
+						Name:  "workers",
+						Usage: "Workbooks searched concurrently (0 for one per CPU)",
+					},
+					&cli.BoolFlag{
+						Name:  "json",
+						Usage: "Print hits as JSON",
+					},
+					&cli.BoolFlag{
+						Name:  "pretty",
+						Usage: "Pretty print JSON output",
+					},
 				},
 				Action: handleSearch,
 			},
+			{
+				Name:      "diff",
+				Aliases:   []string{"d"},
+				Usage:     "Emit an RFC 6902 JSON Patch between two Excel or metadata files",
+				ArgsUsage: "<base> <target>",
+				Flags: []cli.Flag{
+					redactFlag(),
+					redactKeyFlag(),
+					&cli.StringFlag{
+						Name:    "output",
+						Aliases: []string{"o"},
+						Usage:   "Output patch file path",
+					},
+					&cli.BoolFlag{
+						Name:    "pretty",
+						Aliases: []string{"p"},
+						Usage:   "Pretty print JSON output",
+					},
+				},
+				Action: handleDiff,
+			},
+			{
+				Name:      "patch",
+				Usage:     "Apply a JSON Patch to Excel or metadata file",
+				ArgsUsage: "<base> <patch.json>",
+				Flags: []cli.Flag{
+					redactFlag(),
+					redactKeyFlag(),
+					&cli.StringFlag{
+						Name:    "output",
+						Aliases: []string{"o"},
+						Usage:   "Output metadata JSON file path",
+					},
+					&cli.BoolFlag{
+						Name:    "pretty",
+						Aliases: []string{"p"},
+						Usage:   "Pretty print JSON output",
+					},
+				},
+				Action: handlePatch,
+			},
+			{
+				Name:      "codegen",
+				Usage:     "Generate Go row structs from header rows, or TypeScript metadata declarations",
+				ArgsUsage: "<file>",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "lang",
+						Usage: "Target language: go (row structs) or ts (metadata document types)",
+						Value: "go",
+					},
+					&cli.StringFlag{
+						Name:    "output",
+						Aliases: []string{"o"},
+						Usage:   "Output source file path",
+					},
+					&cli.StringFlag{
+						Name:  "package",
+						Usage: "Package name of generated source",
+						Value: "models",
+					},
+					&cli.IntFlag{
+						Name:  "header-row",
+						Usage: "Header row of sheets without tables",
+						Value: 1,
+					},
+				},
+				Action: handleCodegen,
+			},
+			{
+				Name:      "report",
+				Usage:     "Render a Markdown or HTML report of an Excel or metadata file",
+				ArgsUsage: "<file>",
+				Flags: []cli.Flag{
+					redactFlag(),
+					redactKeyFlag(),
+					&cli.StringFlag{
+						Name:    "output",
+						Aliases: []string{"o"},
+						Usage:   "Output report path (.md or .html)",
+					},
+					&cli.StringFlag{
+						Name:    "format",
+						Aliases: []string{"f"},
+						Usage:   "Report format: markdown or html (default: from output extension, markdown for stdout)",
+					},
+					&cli.IntFlag{
+						Name:  "rows",
+						Usage: "Preview rows per sheet (negative to disable)",
+						Value: excelmetadata.DefaultPreviewRows,
+					},
+					&cli.IntFlag{
+						Name:  "cols",
+						Usage: "Maximum preview columns per sheet (0 for all)",
+					},
+				},
+				Action: handleReport,
+			},
+			{
+				Name:      "export-csv",
+				Usage:     "Export the cell grid of every sheet as CSV",
+				ArgsUsage: "<file>",
+				Flags: []cli.Flag{
+					redactFlag(),
+					redactKeyFlag(),
+					&cli.StringFlag{
+						Name:    "output",
+						Aliases: []string{"o"},
+						Usage:   "Output directory, or output file with --single (default: current directory, stdout with --single)",
+					},
+					&cli.BoolFlag{
+						Name:  "single",
+						Usage: "Write all sheets to one CSV with a sheet column",
+					},
+					&cli.BoolFlag{
+						Name:  "raw",
+						Usage: "Write unformatted cell values",
+					},
+					&cli.BoolFlag{
+						Name:  "formulas",
+						Usage: "Write formula text instead of values for formula cells",
+					},
+					&cli.BoolFlag{
+						Name:  "fill-merged",
+						Usage: "Repeat the value of merged ranges in every covered cell",
+					},
+					&cli.IntFlag{
+						Name:  "header-row",
+						Usage: "Row written first as header; rows above it are skipped (0 for none)",
+					},
+					&cli.StringFlag{
+						Name:    "delimiter",
+						Aliases: []string{"d"},
+						Usage:   "Field delimiter, a single character or \"tab\"",
+						Value:   ",",
+					},
+					&cli.BoolFlag{
+						Name:  "quote-all",
+						Usage: "Quote every field",
+					},
+					&cli.BoolFlag{
+						Name:  "crlf",
+						Usage: "End lines with CRLF",
+					},
+				},
+				Action: handleExportCSV,
+			},
+			{
+				Name:      "export-sqlite",
+				Usage:     "Append the metadata of Excel or metadata files to a SQLite database",
+				ArgsUsage: "<file>...",
+				Flags: []cli.Flag{
+					redactFlag(),
+					redactKeyFlag(),
+					&cli.StringFlag{
+						Name:     "output",
+						Aliases:  []string{"o"},
+						Usage:    "SQLite database path, created when missing",
+						Required: true,
+					},
+				},
+				Action: handleExportSQLite,
+			},
+			{
+				Name:      "query",
+				Aliases:   []string{"q"},
+				Usage:     "Select sheets, cells, styles and other nodes with a selector expression",
+				ArgsUsage: "<file> <expr>",
+				Flags: []cli.Flag{
+					redactFlag(),
+					redactKeyFlag(),
+					&cli.BoolFlag{
+						Name:  "json",
+						Usage: "Print results as JSON",
+					},
+					&cli.BoolFlag{
+						Name:    "pretty",
+						Aliases: []string{"p"},
+						Usage:   "Pretty print JSON output",
+					},
+				},
+				Action: handleQuery,
+			},
+			{
+				Name:      "deps",
+				Usage:     "Export the formula dependency graph, or the precedents and dependents of a cell",
+				ArgsUsage: "<file>",
+				Flags: []cli.Flag{
+					redactFlag(),
+					redactKeyFlag(),
+					&cli.StringFlag{
+						Name:    "format",
+						Aliases: []string{"f"},
+						Usage:   "Graph format: dot or json",
+						Value:   "dot",
+					},
+					&cli.StringFlag{
+						Name:    "output",
+						Aliases: []string{"o"},
+						Usage:   "Output file path (default: stdout)",
+					},
+					&cli.StringFlag{
+						Name:    "cell",
+						Aliases: []string{"c"},
+						Usage:   "List the precedents and dependents of a cell or range, e.g. 'Sheet1!B2'",
+					},
+					&cli.BoolFlag{
+						Name:    "transitive",
+						Aliases: []string{"t"},
+						Usage:   "Follow precedents and dependents through other formulas with --cell",
+					},
+					&cli.BoolFlag{
+						Name:    "pretty",
+						Aliases: []string{"p"},
+						Usage:   "Pretty print JSON output",
+					},
+				},
+				Action: handleDeps,
+			},
+			{
+				Name:      "verify-calc",
+				Usage:     "Recalculate formulas and report cached values that are stale or cannot be verified",
+				ArgsUsage: "<file>",
+				Flags: []cli.Flag{
+					redactFlag(),
+					redactKeyFlag(),
+					&cli.StringSliceFlag{
+						Name:    "sheet",
+						Aliases: []string{"s"},
+						Usage:   "Only check matching sheets: a name, a glob or a /regexp/ (repeatable)",
+					},
+					&cli.BoolFlag{
+						Name:  "json",
+						Usage: "Print the report as JSON",
+					},
+					&cli.BoolFlag{
+						Name:    "pretty",
+						Aliases: []string{"p"},
+						Usage:   "Pretty print JSON output",
+					},
+				},
+				Action: handleVerifyCalc,
+			},
+			{
+				Name:      "lint",
+				Usage:     "Check a workbook or metadata JSON for common spreadsheet mistakes",
+				ArgsUsage: "<file>",
+				Flags: []cli.Flag{
+					redactFlag(),
+					redactKeyFlag(),
+					&cli.StringFlag{
+						Name:    "config",
+						Aliases: []string{"c"},
+						Usage:   "YAML file setting rule severities and options",
+					},
+					&cli.StringFlag{
+						Name:    "format",
+						Aliases: []string{"f"},
+						Usage:   "Output format: text, json or sarif",
+						Value:   "text",
+					},
+					&cli.StringFlag{
+						Name:    "output",
+						Aliases: []string{"o"},
+						Usage:   "Output file path (default: stdout)",
+					},
+					&cli.BoolFlag{
+						Name:    "pretty",
+						Aliases: []string{"p"},
+						Usage:   "Pretty print JSON output",
+					},
+					&cli.StringFlag{
+						Name:  "fail-on",
+						Usage: "Exit with status 1 on findings of this severity or above: error, warning, info or none",
+						Value: excelmetadata.SeverityError,
+					},
+					&cli.BoolFlag{
+						Name:  "list-rules",
+						Usage: "List the available rules and their default severity",
+					},
+				},
+				Action: handleLint,
+			},
+			{
+				Name:      "audit",
+				Usage:     "Screen a workbook for macros, external links, DDE and OLE objects, unsafe hyperlinks and hidden sheets",
+				ArgsUsage: "<file>",
+				Flags: []cli.Flag{
+					redactFlag(),
+					redactKeyFlag(),
+					&cli.BoolFlag{
+						Name:  "json",
+						Usage: "Print the report as JSON",
+					},
+					&cli.BoolFlag{
+						Name:    "pretty",
+						Aliases: []string{"p"},
+						Usage:   "Pretty print JSON output",
+					},
+				},
+				Action: handleAudit,
+			},
+			{
+				Name:      "scan-pii",
+				Usage:     "List the cells and properties holding personal data or secrets",
+				ArgsUsage: "<file>",
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  "json",
+						Usage: "Print the findings as JSON",
+					},
+					&cli.BoolFlag{
+						Name:    "pretty",
+						Aliases: []string{"p"},
+						Usage:   "Pretty print JSON output",
+					},
+				},
+				Action: handleScanPII,
+			},
+			{
+				Name:      "textconv",
+				Usage:     "Print a line-oriented rendering of an Excel file for git textconv",
+				ArgsUsage: "<file>",
+				Flags:     []cli.Flag{redactFlag(), redactKeyFlag()},
+				Action:    handleTextconv,
+			},
+			{
+				Name:      "git-diff",
+				Usage:     "External diff driver for git (diff.<driver>.command)",
+				ArgsUsage: "<path> <old-file> <old-hex> <old-mode> <new-file> <new-hex> <new-mode>",
+				Flags:     []cli.Flag{redactFlag(), redactKeyFlag()},
+				Action:    handleGitDiff,
+			},
 		},
 	}
 
+	// Exit status 1 is reserved for the findings of verify-calc, lint, audit
+	// and scan-pii, so CI can tell them apart from files that failed to load
 	if err := app.Run(os.Args); err != nil {
-		log.Fatal(err)
+		log.Print(err)
+		os.Exit(exitError)
 	}
 }
 
+// exitError is the exit status of commands that fail
+const exitError = 2
+
 func handleExtract(c *cli.Context) error {
+	options := extractOptions(c)
+	if dir := c.String("dir"); dir != "" {
+		return handleBatchExtract(c, dir, options)
+	}
+
 	inputFile := c.Args().First()
 	if inputFile == "" {
 		return fmt.Errorf("please provide an input file")
 	}
 
-	options := &excelmetadata.Options{
-		IncludeCellData:       true,
-		IncludeStyles:         !c.Bool("no-styles"),
-		IncludeImages:         !c.Bool("no-images"),
-		IncludeDefinedNames:   true,
-		IncludeDataValidation: true,
-		MaxCellsPerSheet:      c.Int("max-cells"),
-	}
-
 	extractor, err := excelmetadata.New(inputFile, options)
 	if err != nil {
 		return fmt.Errorf("failed to create extractor: %v", err)
@@ -114,28 +574,21 @@ func handleExtract(c *cli.Context) error {
 		_ = extractor.Close()
 	}(extractor)
 
-	metadata, err := extractor.Extract()
-	if err != nil {
-		return fmt.Errorf("failed to extract metadata: %v", err)
-	}
-
 	outputFile := c.String("output")
-	fmt.Println("output file:", outputFile)
 	if outputFile == "" {
 		// Print to stdout
-		var jsonData []byte
-		if c.Bool("pretty") {
-			jsonData, err = json.MarshalIndent(metadata, "", "  ")
-		} else {
-			jsonData, err = json.Marshal(metadata)
+		format := options.Format
+		if format == "" {
+			format = excelmetadata.FormatJSON
 		}
-		if err != nil {
-			return fmt.Errorf("failed to marshal JSON: %v", err)
+		var buf bytes.Buffer
+		if err := extractor.ExtractTo(&buf, format, c.Bool("pretty")); err != nil {
+			return fmt.Errorf("failed to extract metadata: %v", err)
 		}
-		fmt.Println(string(jsonData))
+		fmt.Println(strings.TrimRight(buf.String(), "\n"))
 	} else {
 		// Save to file
-		err = excelmetadata.QuickExtractToFile(inputFile, outputFile, c.Bool("pretty"))
+		err = extractor.ExtractToFile(outputFile, c.Bool("pretty"))
 		if err != nil {
 			return fmt.Errorf("failed to save to file: %v", err)
 		}
@@ -145,25 +598,153 @@ func handleExtract(c *cli.Context) error {
 	return nil
 }
 
-func handleCompare(c *cli.Context) error {
-	if c.Args().Len() < 2 {
-		return fmt.Errorf("please provide two files to compare")
-	}
-
-	file1 := c.Args().Get(0)
-	file2 := c.Args().Get(1)
-
-	metadata1, err := excelmetadata.QuickExtract(file1)
-	if err != nil {
-		return fmt.Errorf("failed to extract metadata from %s: %v", file1, err)
+// redactFlag selects the redaction policy of exported metadata
+func redactFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:  "redact",
+		Usage: "Redact personal data, secrets and sheet passwords: mask, hash or drop",
 	}
+}
 
-	metadata2, err := excelmetadata.QuickExtract(file2)
-	if err != nil {
-		return fmt.Errorf("failed to extract metadata from %s: %v", file2, err)
+// redactKeyFlag sets the HMAC key of --redact hash, so hashes of the same
+// value compare equal across runs
+func redactKeyFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:    "redact-key",
+		Usage:   "HMAC key of --redact hash (default: a random key per run)",
+		EnvVars: []string{"EXCELMETADATA_REDACT_KEY"},
 	}
+}
 
-	fmt.Printf("Comparing %s with %s:\n", file1, file2)
+// extractOptions builds extraction options from the extract flags
+func extractOptions(c *cli.Context) *excelmetadata.Options {
+	return &excelmetadata.Options{
+		IncludeCellData:       true,
+		IncludeStyles:         !c.Bool("no-styles"),
+		IncludeImages:         !c.Bool("no-images"),
+		IncludeDefinedNames:   true,
+		IncludeDataValidation: true,
+		IncludePasswordHash:   c.Bool("password-hash"),
+		MaxCellsPerSheet:      c.Int("max-cells"),
+		MaxRows:               c.Int("max-rows"),
+		MaxCols:               c.Int("max-cols"),
+		Sampling:              c.String("sample"),
+		SampleSeed:            c.Int64("seed"),
+		ParseFormulas:         c.Bool("parse-formulas"),
+		VerifyCalculation:     c.Bool("verify-calc"),
+		AuditSecurity:         c.Bool("audit"),
+		Redact:                c.String("redact"),
+		RedactKey:             []byte(c.String("redact-key")),
+		Deterministic:         c.Bool("deterministic"),
+		GoPackage:             c.String("go-package"),
+		GoVariable:            c.String("go-var"),
+		Format:                c.String("format"),
+		Sheets:                c.StringSlice("sheet"),
+		ExcludeSheets:         c.StringSlice("exclude-sheet"),
+		SkipHiddenSheets:      c.Bool("skip-hidden"),
+		Ranges:                c.StringSlice("range"),
+	}
+}
+
+// handleBatchExtract extracts every workbook below dir concurrently and
+// writes one metadata file per workbook, mirroring the source tree
+func handleBatchExtract(c *cli.Context, dir string, options *excelmetadata.Options) error {
+	outputDir := c.String("output")
+	if outputDir == "" {
+		return fmt.Errorf("please provide an output directory with --output")
+	}
+
+	format := options.Format
+	if format == "" {
+		format = excelmetadata.FormatJSON
+	}
+	ext := ""
+	for _, info := range excelmetadata.Encoders() {
+		if info.Name == strings.ToLower(format) && len(info.Extensions) > 0 {
+			ext = info.Extensions[0]
+		}
+	}
+	if ext == "" {
+		return fmt.Errorf("unsupported format %q", format)
+	}
+
+	var paths []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && isWorkbookFile(info.Name()) {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	encodeOptions := &excelmetadata.EncodeOptions{
+		Pretty:     c.Bool("pretty"),
+		GoPackage:  options.GoPackage,
+		GoVariable: options.GoVariable,
+	}
+	done, failed := 0, 0
+	excelmetadata.BatchExtractFunc(paths, options, c.Int("workers"), func(result excelmetadata.BatchResult) {
+		done++
+		err := result.Err
+		var outputFile string
+		if err == nil {
+			rel, relErr := filepath.Rel(dir, result.Path)
+			if relErr != nil {
+				rel = filepath.Base(result.Path)
+			}
+			outputFile = filepath.Join(outputDir, strings.TrimSuffix(rel, filepath.Ext(rel))+".metadata"+ext)
+
+			var buf bytes.Buffer
+			if err = excelmetadata.Encode(&buf, format, result.Metadata, encodeOptions); err == nil {
+				err = writeOutput(outputFile, buf.Bytes())
+			}
+		}
+		if err != nil {
+			failed++
+			fmt.Fprintf(os.Stderr, "[%d/%d] %s: %v\n", done, len(paths), result.Path, err)
+			return
+		}
+		fmt.Fprintf(os.Stderr, "[%d/%d] %s -> %s\n", done, len(paths), result.Path, outputFile)
+	})
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d workbooks failed", failed, len(paths))
+	}
+	fmt.Printf("Metadata of %d workbooks saved to %s\n", len(paths), outputDir)
+	return nil
+}
+
+func handleFormats(c *cli.Context) error {
+	for _, info := range excelmetadata.Encoders() {
+		fmt.Printf("%-8s %s\n", info.Name, strings.Join(info.Extensions, ", "))
+	}
+	return nil
+}
+
+func handleCompare(c *cli.Context) error {
+	if c.Args().Len() < 2 {
+		return fmt.Errorf("please provide two files to compare")
+	}
+
+	file1 := c.Args().Get(0)
+	file2 := c.Args().Get(1)
+
+	metadata1, err := excelmetadata.QuickExtract(file1)
+	if err != nil {
+		return fmt.Errorf("failed to extract metadata from %s: %v", file1, err)
+	}
+
+	metadata2, err := excelmetadata.QuickExtract(file2)
+	if err != nil {
+		return fmt.Errorf("failed to extract metadata from %s: %v", file2, err)
+	}
+
+	fmt.Printf("Comparing %s with %s:\n", file1, file2)
 	fmt.Printf("Sheets: %d vs %d\n", len(metadata1.Sheets), len(metadata2.Sheets))
 
 	if c.Bool("detail") {
@@ -181,9 +762,8 @@ func handleCompare(c *cli.Context) error {
 }
 
 func handleSearch(c *cli.Context) error {
-	searchDir := c.Args().First()
-	if searchDir == "" {
-		return fmt.Errorf("please provide a directory to search")
+	if c.Args().Len() == 0 {
+		return fmt.Errorf("please provide a directory or file to search")
 	}
 
 	searchPattern := c.String("pattern")
@@ -191,60 +771,779 @@ func handleSearch(c *cli.Context) error {
 		return fmt.Errorf("please provide a search pattern")
 	}
 
-	walkFunc := func(path string, info os.FileInfo, err error) error {
+	var targets []string
+	for _, t := range c.StringSlice("target") {
+		targets = append(targets, strings.Split(t, ",")...)
+	}
+	searcher, err := excelmetadata.NewSearcher(searchPattern, &excelmetadata.SearchOptions{
+		Regex:      c.Bool("regex"),
+		IgnoreCase: c.Bool("ignore-case"),
+		WholeWord:  c.Bool("word"),
+		Targets:    targets,
+	})
+	if err != nil {
+		return err
+	}
+
+	files, err := searchFiles(c.Args().Slice(), c.Bool("recursive"))
+	if err != nil {
+		return err
+	}
+
+	// Workbooks are extracted concurrently and only their hits are kept;
+	// hits and errors are reported in the order of files
+	fileHits := make(map[string][]excelmetadata.SearchHit)
+	fileErrs := make(map[string]error)
+	search := func(file string, metadata *excelmetadata.Metadata, err error) {
 		if err != nil {
-			return err
+			fileErrs[file] = err
+			return
+		}
+		metadata.Filename = file
+		fileHits[file] = searcher.Search(metadata)
+	}
+	var workbooks []string
+	for _, file := range files {
+		if strings.ToLower(filepath.Ext(file)) == ".json" {
+			metadata, err := loadMetadata(c, file)
+			search(file, metadata, err)
+		} else {
+			workbooks = append(workbooks, file)
+		}
+	}
+	options := excelmetadata.DefaultOptions()
+	options.Redact = c.String("redact")
+	options.RedactKey = []byte(c.String("redact-key"))
+	excelmetadata.BatchExtractFunc(workbooks, options, c.Int("workers"), func(result excelmetadata.BatchResult) {
+		search(result.Path, result.Metadata, result.Err)
+	})
+
+	hits := []excelmetadata.SearchHit{}
+	for _, file := range files {
+		if err, ok := fileErrs[file]; ok {
+			fmt.Fprintf(os.Stderr, "Error processing %s: %v\n", file, err)
+			continue
 		}
-		if !info.IsDir() && strings.HasSuffix(strings.ToLower(path), ".xlsx") {
-			metadata, err := excelmetadata.QuickExtract(path)
+		hits = append(hits, fileHits[file]...)
+	}
+
+	if c.Bool("json") || c.Bool("pretty") {
+		return writeJSON("", hits, c.Bool("pretty"))
+	}
+	for _, hit := range hits {
+		location := hit.Sheet
+		if hit.Cell != "" {
+			location += "!" + hit.Cell
+		}
+		if hit.Field != "" {
+			location = strings.TrimPrefix(location+"!"+hit.Field, "!")
+		}
+		fmt.Printf("%s\t%s\t%s\t%s\n", hit.File, location, hit.Target, hit.Match)
+	}
+	return nil
+}
+
+// isWorkbookFile reports whether name is an .xlsx or .xlsm file, skipping the
+// ~$ lock files Excel leaves next to open workbooks
+func isWorkbookFile(name string) bool {
+	name = strings.ToLower(name)
+	return !strings.HasPrefix(name, "~$") &&
+		(strings.HasSuffix(name, ".xlsx") || strings.HasSuffix(name, ".xlsm"))
+}
+
+// searchFiles expands directories to the Excel files they contain
+func searchFiles(args []string, recursive bool) ([]string, error) {
+	var files []string
+	for _, arg := range args {
+		info, err := os.Stat(arg)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, arg)
+			continue
+		}
+
+		if !recursive {
+			entries, err := os.ReadDir(arg)
 			if err != nil {
-				fmt.Printf("Error processing %s: %v\n", path, err)
-				return nil
+				return nil, fmt.Errorf("failed to list Excel files: %v", err)
 			}
-
-			if searchInMetadata(metadata, searchPattern) {
-				fmt.Printf("Match found in: %s\n", path)
+			for _, entry := range entries {
+				if !entry.IsDir() && isWorkbookFile(entry.Name()) {
+					files = append(files, filepath.Join(arg, entry.Name()))
+				}
+			}
+			continue
+		}
+		err = filepath.Walk(arg, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.IsDir() && isWorkbookFile(info.Name()) {
+				files = append(files, path)
 			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
+	}
+	return files, nil
+}
+
+func handleDiff(c *cli.Context) error {
+	if c.Args().Len() < 2 {
+		return fmt.Errorf("please provide base and target files")
+	}
+
+	base, err := loadMetadata(c, c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	target, err := loadMetadata(c, c.Args().Get(1))
+	if err != nil {
+		return err
+	}
+
+	patch, err := excelmetadata.Diff(base, target)
+	if err != nil {
+		return fmt.Errorf("failed to diff metadata: %v", err)
+	}
+
+	return writeJSON(c.String("output"), patch, c.Bool("pretty"))
+}
+
+func handlePatch(c *cli.Context) error {
+	if c.Args().Len() < 2 {
+		return fmt.Errorf("please provide base file and patch file")
+	}
+
+	base, err := loadMetadata(c, c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(c.Args().Get(1))
+	if err != nil {
+		return fmt.Errorf("failed to read patch: %v", err)
+	}
+	patch, err := excelmetadata.ParsePatch(data)
+	if err != nil {
+		return err
+	}
+
+	patched, err := excelmetadata.ApplyPatch(base, patch)
+	if err != nil {
+		return fmt.Errorf("failed to apply patch: %v", err)
+	}
+
+	return writeJSON(c.String("output"), patched, c.Bool("pretty"))
+}
+
+// loadMetadata extracts metadata from an Excel file or reads a metadata JSON
+// file, then applies the --redact policy of the command, if any
+func loadMetadata(c *cli.Context, filename string) (*excelmetadata.Metadata, error) {
+	return loadMetadataWithOptions(c, filename, excelmetadata.DefaultOptions())
+}
+
+// loadMetadataWithOptions is loadMetadata extracting workbooks with options
+func loadMetadataWithOptions(c *cli.Context, filename string, options *excelmetadata.Options) (*excelmetadata.Metadata, error) {
+	var metadata *excelmetadata.Metadata
+	if strings.ToLower(filepath.Ext(filename)) != ".json" {
+		extractor, err := excelmetadata.New(filename, options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract metadata from %s: %v", filename, err)
+		}
+		defer func(extractor *excelmetadata.Extractor) {
+			_ = extractor.Close()
+		}(extractor)
+		if metadata, err = extractor.Extract(); err != nil {
+			return nil, fmt.Errorf("failed to extract metadata from %s: %v", filename, err)
+		}
+	} else {
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %v", filename, err)
+		}
+		metadata = &excelmetadata.Metadata{}
+		if err := json.Unmarshal(data, metadata); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", filename, err)
+		}
+	}
+
+	if err := redact(c, metadata); err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+// redact applies the --redact policy of the command to metadata
+func redact(c *cli.Context, metadata *excelmetadata.Metadata) error {
+	policy := c.String("redact")
+	if policy == "" {
 		return nil
 	}
+	_, err := excelmetadata.RedactMetadata(metadata, policy, []byte(c.String("redact-key")))
+	return err
+}
 
-	if c.Bool("recursive") {
-		return filepath.Walk(searchDir, walkFunc)
+// writeJSON prints v to stdout or saves it to outputFile
+func writeJSON(outputFile string, v interface{}, pretty bool) error {
+	var jsonData []byte
+	var err error
+	if pretty {
+		jsonData, err = json.MarshalIndent(v, "", "  ")
+	} else {
+		jsonData, err = json.Marshal(v)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %v", err)
 	}
 
-	files, err := filepath.Glob(filepath.Join(searchDir, "*.xlsx"))
+	if outputFile == "" {
+		fmt.Println(string(jsonData))
+		return nil
+	}
+	return writeOutput(outputFile, jsonData)
+}
+
+func handleTextconv(c *cli.Context) error {
+	inputFile := c.Args().First()
+	if inputFile == "" {
+		return fmt.Errorf("please provide an input file")
+	}
+
+	metadata, err := loadMetadata(c, inputFile)
 	if err != nil {
-		return fmt.Errorf("failed to list Excel files: %v", err)
+		return err
 	}
 
-	for _, file := range files {
-		if err := walkFunc(file, nil, nil); err != nil {
+	return excelmetadata.WriteText(os.Stdout, metadata)
+}
+
+func handleGitDiff(c *cli.Context) error {
+	// git calls: path old-file old-hex old-mode new-file new-hex new-mode
+	var name, oldFile, newFile string
+	switch c.Args().Len() {
+	case 2:
+		oldFile, newFile = c.Args().Get(0), c.Args().Get(1)
+		name = newFile
+	case 7:
+		name, oldFile, newFile = c.Args().Get(0), c.Args().Get(1), c.Args().Get(4)
+	default:
+		return fmt.Errorf("expected 7 arguments from git or <old-file> <new-file>")
+	}
+
+	oldLines, err := textLines(c, oldFile)
+	if err != nil {
+		return err
+	}
+	newLines, err := textLines(c, newFile)
+	if err != nil {
+		return err
+	}
+
+	diff := unifiedDiff("a/"+name, "b/"+name, oldLines, newLines)
+	if diff != "" {
+		fmt.Printf("diff --git a/%s b/%s\n%s", name, name, diff)
+	}
+	return nil
+}
+
+// textLines renders a file for git-diff; /dev/null stands for an added or deleted file
+func textLines(c *cli.Context, filename string) ([]string, error) {
+	if filename == os.DevNull {
+		return nil, nil
+	}
+	metadata, err := loadMetadata(c, filename)
+	if err != nil {
+		return nil, err
+	}
+	return excelmetadata.TextLines(metadata), nil
+}
+
+func handleCodegen(c *cli.Context) error {
+	switch c.String("lang") {
+	case "go":
+	case "ts", "typescript":
+		return writeOutput(c.String("output"), excelmetadata.GenerateTypeScript())
+	default:
+		return fmt.Errorf("unsupported language %q", c.String("lang"))
+	}
+
+	inputFile := c.Args().First()
+	if inputFile == "" {
+		return fmt.Errorf("please provide an input file")
+	}
+
+	metadata, err := loadMetadata(c, inputFile)
+	if err != nil {
+		return err
+	}
+
+	src, err := excelmetadata.GenerateStructs(metadata, &excelmetadata.StructOptions{
+		PackageName: c.String("package"),
+		HeaderRow:   c.Int("header-row"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to generate code: %v", err)
+	}
+
+	return writeOutput(c.String("output"), src)
+}
+
+func handleReport(c *cli.Context) error {
+	inputFile := c.Args().First()
+	if inputFile == "" {
+		return fmt.Errorf("please provide an input file")
+	}
+
+	outputFile := c.String("output")
+	format := strings.ToLower(c.String("format"))
+	if format == "" {
+		format = excelmetadata.FormatMarkdown
+		if name, ok := excelmetadata.FormatForExtension(filepath.Ext(outputFile)); ok && outputFile != "" {
+			format = name
+		}
+	}
+
+	metadata, err := loadMetadata(c, inputFile)
+	if err != nil {
+		return err
+	}
+
+	options := &excelmetadata.ReportOptions{
+		PreviewRows: c.Int("rows"),
+		PreviewCols: c.Int("cols"),
+	}
+	var report []byte
+	switch format {
+	case excelmetadata.FormatMarkdown, "md":
+		report, err = excelmetadata.MarshalMarkdown(metadata, options)
+	case excelmetadata.FormatHTML, "htm":
+		report, err = excelmetadata.MarshalHTML(metadata, options)
+	default:
+		return fmt.Errorf("unsupported report format %q", format)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to render report: %v", err)
+	}
+
+	return writeOutput(outputFile, report)
+}
+
+func handleExportCSV(c *cli.Context) error {
+	inputFile := c.Args().First()
+	if inputFile == "" {
+		return fmt.Errorf("please provide an input file")
+	}
+
+	delimiter := c.String("delimiter")
+	if strings.EqualFold(delimiter, "tab") || delimiter == `\t` {
+		delimiter = "\t"
+	}
+	comma, size := utf8.DecodeRuneInString(delimiter)
+	if size == 0 || size != len(delimiter) {
+		return fmt.Errorf("delimiter must be a single character, got %q", delimiter)
+	}
+
+	metadata, err := loadMetadata(c, inputFile)
+	if err != nil {
+		return err
+	}
+
+	options := &excelmetadata.CSVOptions{
+		Raw:        c.Bool("raw"),
+		Formulas:   c.Bool("formulas"),
+		FillMerged: c.Bool("fill-merged"),
+		HeaderRow:  c.Int("header-row"),
+		Comma:      comma,
+		QuoteAll:   c.Bool("quote-all"),
+		UseCRLF:    c.Bool("crlf"),
+	}
+
+	if c.Bool("single") {
+		var buf bytes.Buffer
+		if err := excelmetadata.WriteCSV(&buf, metadata, options); err != nil {
+			return fmt.Errorf("failed to export CSV: %v", err)
+		}
+		return writeOutput(c.String("output"), buf.Bytes())
+	}
+
+	dir := c.String("output")
+	if dir == "" {
+		dir = "."
+	}
+	paths, err := excelmetadata.ExportCSV(metadata, dir, options)
+	if err != nil {
+		return fmt.Errorf("failed to export CSV: %v", err)
+	}
+	for _, path := range paths {
+		fmt.Printf("Sheet saved to %s\n", path)
+	}
+	return nil
+}
+
+func handleExportSQLite(c *cli.Context) error {
+	if c.Args().Len() == 0 {
+		return fmt.Errorf("please provide at least one input file")
+	}
+
+	// Workbooks are written as they are loaded, so only one is held in
+	// memory at a time
+	outputFile := c.String("output")
+	db, err := sql.Open(excelmetadata.SQLiteDriver, outputFile)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %v", outputFile, err)
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+	if err := excelmetadata.CreateSQLiteSchema(db); err != nil {
+		return fmt.Errorf("failed to export to SQLite: %v", err)
+	}
+
+	for _, inputFile := range c.Args().Slice() {
+		metadata, err := loadMetadata(c, inputFile)
+		if err != nil {
 			return err
 		}
+		if _, err := excelmetadata.WriteSQLite(db, metadata); err != nil {
+			return fmt.Errorf("failed to export %s to SQLite: %v", inputFile, err)
+		}
+	}
+	fmt.Printf("%d workbook(s) saved to %s\n", c.Args().Len(), outputFile)
+	return nil
+}
+
+func handleQuery(c *cli.Context) error {
+	if c.Args().Len() < 2 {
+		return fmt.Errorf("please provide an input file and a query expression")
 	}
 
+	metadata, err := loadMetadata(c, c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+
+	results, err := excelmetadata.Query(metadata, c.Args().Get(1))
+	if err != nil {
+		return err
+	}
+
+	if c.Bool("json") || c.Bool("pretty") {
+		return writeJSON("", results, c.Bool("pretty"))
+	}
+	for _, r := range results {
+		location := r.Sheet
+		if r.Address != "" {
+			location += "!" + r.Address
+		}
+		fmt.Printf("%s\t%s\t%s\n", r.Kind, location, querySummary(r))
+	}
 	return nil
 }
 
-func searchInMetadata(metadata *excelmetadata.Metadata, pattern string) bool {
-	if pattern == "" {
-		return false
+func handleDeps(c *cli.Context) error {
+	inputFile := c.Args().First()
+	if inputFile == "" {
+		return fmt.Errorf("please provide an input file")
 	}
 
-	for _, sheet := range metadata.Sheets {
-		if strings.Contains(sheet.Name, pattern) {
-			return true
+	metadata, err := loadMetadata(c, inputFile)
+	if err != nil {
+		return err
+	}
+	graph := excelmetadata.NewDependencyGraph(metadata)
+	for _, cycle := range graph.Cycles {
+		fmt.Fprintf(os.Stderr, "circular reference: %s\n", strings.Join(cycle, " -> "))
+	}
+
+	if cell := c.String("cell"); cell != "" {
+		for _, p := range graph.Precedents(cell, c.Bool("transitive")) {
+			fmt.Printf("precedent\t%s\n", p)
+		}
+		for _, d := range graph.Dependents(cell, c.Bool("transitive")) {
+			fmt.Printf("dependent\t%s\n", d)
 		}
+		return nil
+	}
 
-		for _, cell := range sheet.Cells {
-			if value, ok := cell.Value.(string); ok {
-				if strings.Contains(value, pattern) {
-					return true
-				}
+	switch strings.ToLower(c.String("format")) {
+	case "json":
+		return writeJSON(c.String("output"), graph, c.Bool("pretty"))
+	case "dot":
+		var buf bytes.Buffer
+		if err := graph.WriteDOT(&buf); err != nil {
+			return err
+		}
+		return writeOutput(c.String("output"), buf.Bytes())
+	default:
+		return fmt.Errorf("unsupported graph format %q, use dot or json", c.String("format"))
+	}
+}
+
+func handleVerifyCalc(c *cli.Context) error {
+	inputFile := c.Args().First()
+	if inputFile == "" {
+		return fmt.Errorf("please provide an input file")
+	}
+
+	options := excelmetadata.DefaultOptions()
+	options.Sheets = c.StringSlice("sheet")
+	extractor, err := excelmetadata.New(inputFile, options)
+	if err != nil {
+		return fmt.Errorf("failed to create extractor: %v", err)
+	}
+	defer func(extractor *excelmetadata.Extractor) {
+		_ = extractor.Close()
+	}(extractor)
+
+	report, err := extractor.VerifyCalculation()
+	if err != nil {
+		return err
+	}
+	if err := redact(c, &excelmetadata.Metadata{Calculation: report}); err != nil {
+		return err
+	}
+
+	if c.Bool("json") {
+		if err := writeJSON("", report, c.Bool("pretty")); err != nil {
+			return err
+		}
+	} else {
+		fmt.Printf("calculation mode: %s, %d formulas checked\n", report.CalcMode, report.Checked)
+		for _, issue := range report.Stale {
+			fmt.Printf("stale\t%s!%s\t=%s\tcached %q, calculated %q\n", issue.Sheet, issue.Cell, issue.Formula, issue.Cached, issue.Calculated)
+		}
+		for _, issue := range report.Unevaluable {
+			fmt.Printf("unevaluable\t%s!%s\t=%s\t%s\n", issue.Sheet, issue.Cell, issue.Formula, issue.Error)
+		}
+	}
+
+	if len(report.Stale) > 0 {
+		return cli.Exit(fmt.Sprintf("%d stale cached values", len(report.Stale)), 1)
+	}
+	return nil
+}
+
+func handleLint(c *cli.Context) error {
+	if c.Bool("list-rules") {
+		for _, rule := range excelmetadata.LintRules() {
+			fmt.Printf("%-34s %-8s %s\n", rule.ID, rule.Severity, rule.Description)
+		}
+		return nil
+	}
+
+	inputFile := c.Args().First()
+	if inputFile == "" {
+		return fmt.Errorf("please provide an input file")
+	}
+
+	failOn := strings.ToLower(c.String("fail-on"))
+	switch failOn {
+	case excelmetadata.SeverityError, excelmetadata.SeverityWarning, excelmetadata.SeverityInfo, "none":
+	default:
+		return fmt.Errorf("invalid --fail-on %q, use error, warning, info or none", failOn)
+	}
+
+	var config *excelmetadata.LintConfig
+	if configFile := c.String("config"); configFile != "" {
+		var err error
+		if config, err = excelmetadata.LoadLintConfig(configFile); err != nil {
+			return err
+		}
+	}
+	metadata, err := loadMetadata(c, inputFile)
+	if err != nil {
+		return err
+	}
+	findings, err := excelmetadata.Lint(metadata, config)
+	if err != nil {
+		return err
+	}
+
+	switch strings.ToLower(c.String("format")) {
+	case "text":
+		var buf bytes.Buffer
+		for _, finding := range findings {
+			fmt.Fprintf(&buf, "%s: %s: %s [%s]\n", finding.Location(), finding.Severity, finding.Message, finding.Rule)
+		}
+		if err := writeOutput(c.String("output"), buf.Bytes()); err != nil {
+			return err
+		}
+	case "json":
+		if findings == nil {
+			findings = []excelmetadata.LintFinding{}
+		}
+		if err := writeJSON(c.String("output"), findings, c.Bool("pretty")); err != nil {
+			return err
+		}
+	case "sarif":
+		var buf bytes.Buffer
+		if err := excelmetadata.WriteSARIF(&buf, filepath.ToSlash(inputFile), findings); err != nil {
+			return err
+		}
+		if err := writeOutput(c.String("output"), buf.Bytes()); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported lint format %q, use text, json or sarif", c.String("format"))
+	}
+
+	if failOn == "none" {
+		return nil
+	}
+	failed := 0
+	for _, finding := range findings {
+		if excelmetadata.SeverityAtLeast(finding.Severity, failOn) {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return cli.Exit(fmt.Sprintf("%d findings of severity %s or above", failed, failOn), 1)
+	}
+	return nil
+}
+
+func handleAudit(c *cli.Context) error {
+	inputFile := c.Args().First()
+	if inputFile == "" {
+		return fmt.Errorf("please provide an input file")
+	}
+
+	extractor, err := excelmetadata.New(inputFile, excelmetadata.DefaultOptions())
+	if err != nil {
+		return fmt.Errorf("failed to create extractor: %v", err)
+	}
+	defer func(extractor *excelmetadata.Extractor) {
+		_ = extractor.Close()
+	}(extractor)
+
+	report, err := extractor.AuditSecurity()
+	if err != nil {
+		return err
+	}
+	if err := redact(c, &excelmetadata.Metadata{Security: report}); err != nil {
+		return err
+	}
+
+	if c.Bool("json") {
+		if err := writeJSON("", report, c.Bool("pretty")); err != nil {
+			return err
+		}
+	} else if report.Findings() == 0 {
+		fmt.Println("no security findings")
+	} else {
+		if p := report.VBAProject; p != nil {
+			fmt.Printf("macros\t%s\tmodules: %s\tsigned: %t\n", p.Part, strings.Join(p.Modules, ", "), p.Signed)
+		}
+		for _, link := range report.ExternalLinks {
+			fmt.Printf("external %s link\t%s\n", link.Kind, link.Target)
+		}
+		for _, cell := range report.DDEFormulas {
+			fmt.Printf("dde formula\t%s!%s\t=%s\n", cell.Sheet, cell.Cell, cell.Target)
+		}
+		for _, object := range report.OLEObjects {
+			location := object.Sheet
+			if location == "" {
+				location = "(not anchored)"
+			}
+			source := object.Part
+			if object.Target != "" {
+				source = "linked " + object.Target
 			}
+			fmt.Printf("ole object\t%s\t%s %s %s\n", location, object.ProgID, source, object.Filename)
+		}
+		for _, cell := range report.Hyperlinks {
+			fmt.Printf("hyperlink\t%s!%s\t%s\n", cell.Sheet, cell.Cell, cell.Target)
 		}
+		for _, sheet := range report.HiddenSheets {
+			fmt.Printf("%s sheet\t%s\n", sheet.State, sheet.Name)
+		}
+	}
+
+	if n := report.Findings(); n > 0 {
+		return cli.Exit(fmt.Sprintf("%d security findings", n), 1)
 	}
+	return nil
+}
 
-	return false
+func handleScanPII(c *cli.Context) error {
+	inputFile := c.Args().First()
+	if inputFile == "" {
+		return fmt.Errorf("please provide an input file")
+	}
+
+	// Password hashes are extracted so protected sheets are reported, the
+	// findings never carry values
+	options := excelmetadata.DefaultOptions()
+	options.IncludePasswordHash = true
+	metadata, err := loadMetadataWithOptions(c, inputFile, options)
+	if err != nil {
+		return err
+	}
+	findings := excelmetadata.ScanPII(metadata)
+
+	if c.Bool("json") {
+		if err := writeJSON("", findings, c.Bool("pretty")); err != nil {
+			return err
+		}
+	} else if len(findings) == 0 {
+		fmt.Println("no personal data found")
+	} else {
+		for _, f := range findings {
+			location := f.Sheet
+			if f.Ref != "" && location != "" {
+				location += "!" + f.Ref
+			} else if f.Ref != "" {
+				location = f.Ref
+			}
+			fmt.Printf("%s\t%s\t%s\n", f.Class, f.Field, location)
+		}
+	}
+
+	if len(findings) > 0 {
+		return cli.Exit(fmt.Sprintf("%d fields hold personal data", len(findings)), 1)
+	}
+	return nil
+}
+
+// querySummary describes a query result on one line
+func querySummary(r excelmetadata.QueryResult) string {
+	switch node := r.Node.(type) {
+	case excelmetadata.SheetMetadata:
+		return fmt.Sprintf("%s:%s visible=%t", node.Dimensions.StartCell, node.Dimensions.EndCell, node.Visible)
+	case excelmetadata.CellMetadata:
+		summary := strconv.Quote(fmt.Sprint(node.Value))
+		if node.Formula != "" {
+			summary += " =" + node.Formula
+		}
+		return summary
+	case excelmetadata.StyleDetails:
+		return excelmetadata.StyleSummary(node)
+	case excelmetadata.DefinedName:
+		return node.Name + " = " + node.RefersTo
+	case excelmetadata.ImageMetadata:
+		return fmt.Sprintf("%s (%d bytes)", node.Extension, len(node.File))
+	default:
+		data, _ := json.Marshal(node)
+		return string(data)
+	}
+}
+
+// writeOutput prints data to stdout or saves it to outputFile
+func writeOutput(outputFile string, data []byte) error {
+	if outputFile == "" {
+		_, err := os.Stdout.Write(data)
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(outputFile), 0755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(outputFile, data, 0644); err != nil {
+		return fmt.Errorf("failed to save to file: %v", err)
+	}
+	return nil
 }
@@ -0,0 +1,221 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+const diffContext = 3
+
+type editKind int
+
+const (
+	editEqual editKind = iota
+	editDelete
+	editInsert
+)
+
+type edit struct {
+	kind editKind
+	a, b int // line indexes in a and b
+}
+
+// unifiedDiff renders a unified diff between a and b, or "" when they are equal
+func unifiedDiff(nameA, nameB string, a, b []string) string {
+	edits := diffLines(a, b)
+
+	changed := false
+	for _, e := range edits {
+		if e.kind != editEqual {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return ""
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", nameA, nameB)
+
+	for start := 0; start < len(edits); {
+		// Find the next change
+		for start < len(edits) && edits[start].kind == editEqual {
+			start++
+		}
+		if start == len(edits) {
+			break
+		}
+
+		// Extend the hunk while changes are within 2*context lines
+		end := start
+		for i := start; i < len(edits); i++ {
+			if edits[i].kind != editEqual {
+				end = i + 1
+			} else if i-end >= 2*diffContext {
+				break
+			}
+		}
+
+		from := start - diffContext
+		if from < 0 {
+			from = 0
+		}
+		to := end + diffContext
+		if to > len(edits) {
+			to = len(edits)
+		}
+
+		hunk := edits[from:to]
+		startA, startB := hunk[0].a, hunk[0].b
+		countA, countB := 0, 0
+		for _, e := range hunk {
+			if e.kind != editInsert {
+				countA++
+			}
+			if e.kind != editDelete {
+				countB++
+			}
+		}
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n", hunkRange(startA, countA), hunkRange(startB, countB))
+		for _, e := range hunk {
+			switch e.kind {
+			case editEqual:
+				sb.WriteString(" " + a[e.a] + "\n")
+			case editDelete:
+				sb.WriteString("-" + a[e.a] + "\n")
+			case editInsert:
+				sb.WriteString("+" + b[e.b] + "\n")
+			}
+		}
+
+		start = to
+	}
+
+	return sb.String()
+}
+
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+// diffMaxCost bounds the search for a shortest edit script: past it, the
+// changed lines are reported as deleted and inserted as a block, so a
+// rewritten sheet takes time proportional to its size
+const diffMaxCost = 1024
+
+// diffLines computes a shortest edit script with the linear space variant
+// of the Myers algorithm, so memory stays proportional to the input however
+// many lines changed
+func diffLines(a, b []string) []edit {
+	d := &differ{a: a, b: b}
+	d.compare(0, len(a), 0, len(b))
+	return d.edits
+}
+
+type differ struct {
+	a, b  []string
+	edits []edit
+}
+
+// compare appends the edits turning a[aLo:aHi] into b[bLo:bHi]
+func (d *differ) compare(aLo, aHi, bLo, bHi int) {
+	for aLo < aHi && bLo < bHi && d.a[aLo] == d.b[bLo] {
+		d.edits = append(d.edits, edit{kind: editEqual, a: aLo, b: bLo})
+		aLo++
+		bLo++
+	}
+	suffix := 0
+	for aHi > aLo && bHi > bLo && d.a[aHi-1] == d.b[bHi-1] {
+		aHi--
+		bHi--
+		suffix++
+	}
+
+	// Without a common prefix or suffix at least two edits remain, so both
+	// halves around the middle snake are smaller
+	if x, y, u, v, ok := d.middleSnake(aLo, aHi, bLo, bHi); ok {
+		d.compare(aLo, aLo+x, bLo, bLo+y)
+		for i := 0; i < u-x; i++ {
+			d.edits = append(d.edits, edit{kind: editEqual, a: aLo + x + i, b: bLo + y + i})
+		}
+		d.compare(aLo+u, aHi, bLo+v, bHi)
+	} else {
+		for x := aLo; x < aHi; x++ {
+			d.edits = append(d.edits, edit{kind: editDelete, a: x, b: bLo})
+		}
+		for y := bLo; y < bHi; y++ {
+			d.edits = append(d.edits, edit{kind: editInsert, a: aHi, b: y})
+		}
+	}
+
+	for i := 0; i < suffix; i++ {
+		d.edits = append(d.edits, edit{kind: editEqual, a: aHi + i, b: bHi + i})
+	}
+}
+
+// middleSnake runs the search from both ends until the paths meet and
+// returns the start and end of the snake in the middle of a shortest edit
+// script, relative to aLo and bLo. It gives up when either range is empty
+// or the script costs more than diffMaxCost.
+func (d *differ) middleSnake(aLo, aHi, bLo, bHi int) (x, y, u, v int, ok bool) {
+	n, m := aHi-aLo, bHi-bLo
+	if n == 0 || m == 0 {
+		return 0, 0, 0, 0, false
+	}
+	size := 2*min(n, m) + 2
+	delta := n - m
+	forward, backward := make([]int, size), make([]int, size)
+	at := func(k int) int { return ((k % size) + size) % size }
+
+	for h := 0; h <= (n+m+1)/2 && h <= diffMaxCost/2; h++ {
+		for reverse := 0; reverse < 2; reverse++ {
+			this, other := forward, backward
+			if reverse == 1 {
+				this, other = backward, forward
+			}
+			for k := -(h - 2*max(0, h-m)); k <= h-2*max(0, h-n); k += 2 {
+				var px int
+				if k == -h || k != h && this[at(k-1)] < this[at(k+1)] {
+					px = this[at(k+1)]
+				} else {
+					px = this[at(k-1)] + 1
+				}
+				py := px - k
+				sx, sy := px, py
+				for px < n && py < m && d.equal(aLo, aHi, bLo, bHi, px, py, reverse == 1) {
+					px++
+					py++
+				}
+				this[at(k)] = px
+
+				// The forward path can only meet the backward one on odd
+				// total lengths, the backward path on even ones
+				z := delta - k
+				meets := (n+m)%2 == 1-reverse && z >= -(h-1+reverse) && z <= h-1+reverse
+				if meets && this[at(k)]+other[at(z)] >= n {
+					if reverse == 0 {
+						return sx, sy, px, py, true
+					}
+					return n - px, m - py, n - sx, m - sy, true
+				}
+			}
+		}
+	}
+	return 0, 0, 0, 0, false
+}
+
+// equal compares line x of a with line y of b, counted from the end when
+// reverse is set
+func (d *differ) equal(aLo, aHi, bLo, bHi, x, y int, reverse bool) bool {
+	if reverse {
+		return d.a[aHi-1-x] == d.b[bHi-1-y]
+	}
+	return d.a[aLo+x] == d.b[bLo+y]
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// numbered returns the lines prefix1 to prefixN
+func numbered(prefix string, n int) []string {
+	lines := make([]string, n)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("%s%d", prefix, i+1)
+	}
+	return lines
+}
+
+// replaced returns a copy of lines with the given indexes changed
+func replaced(lines []string, indexes ...int) []string {
+	out := append([]string(nil), lines...)
+	for _, i := range indexes {
+		out[i] += "'"
+	}
+	return out
+}
+
+// replay checks edits turn a into b and returns the number of changes
+func replay(t *testing.T, a, b []string, edits []edit) int {
+	t.Helper()
+	var gotA, gotB []string
+	changes := 0
+	for _, e := range edits {
+		if e.kind != editInsert {
+			gotA = append(gotA, a[e.a])
+		}
+		if e.kind != editDelete {
+			gotB = append(gotB, b[e.b])
+		}
+		if e.kind != editEqual {
+			changes++
+		}
+		if e.kind == editEqual && a[e.a] != b[e.b] {
+			t.Errorf("equal edit pairs %q with %q", a[e.a], b[e.b])
+		}
+	}
+	if strings.Join(gotA, "\n") != strings.Join(a, "\n") || strings.Join(gotB, "\n") != strings.Join(b, "\n") {
+		t.Errorf("edits do not replay a into b:\n%q\n%q", gotA, gotB)
+	}
+	return changes
+}
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    []string
+		changes int
+	}{
+		{"empty", nil, nil, 0},
+		{"equal", numbered("l", 5), numbered("l", 5), 0},
+		{"insert only", nil, numbered("l", 3), 3},
+		{"delete only", numbered("l", 3), nil, 3},
+		{"insert in the middle", []string{"a", "c"}, []string{"a", "b", "c"}, 1},
+		{"delete in the middle", []string{"a", "b", "c"}, []string{"a", "c"}, 1},
+		{"myers paper", strings.Split("abcabba", ""), strings.Split("cbabac", ""), 5},
+		{"replace", numbered("l", 10), replaced(numbered("l", 10), 2, 7), 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if changes := replay(t, tt.a, tt.b, diffLines(tt.a, tt.b)); changes != tt.changes {
+				t.Errorf("changes = %d, want %d", changes, tt.changes)
+			}
+		})
+	}
+}
+
+func TestDiffLinesMaxCost(t *testing.T) {
+	// Every line differs, so the script costs more than diffMaxCost and the
+	// lines are reported as one deleted and one inserted block
+	a, b := numbered("a", diffMaxCost), numbered("b", diffMaxCost)
+	a, b = append(append([]string{"head"}, a...), "tail"), append(append([]string{"head"}, b...), "tail")
+
+	edits := diffLines(a, b)
+	if changes := replay(t, a, b, edits); changes != 2*diffMaxCost {
+		t.Errorf("changes = %d, want %d", changes, 2*diffMaxCost)
+	}
+	var kinds []editKind
+	for _, e := range edits {
+		if len(kinds) == 0 || kinds[len(kinds)-1] != e.kind {
+			kinds = append(kinds, e.kind)
+		}
+	}
+	if want := []editKind{editEqual, editDelete, editInsert, editEqual}; !reflect.DeepEqual(kinds, want) {
+		t.Errorf("edit blocks = %v, want %v", kinds, want)
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	lines := numbered("l", 20)
+	tests := []struct {
+		name string
+		a, b []string
+		want string
+	}{
+		{"empty", nil, nil, ""},
+		{"equal", lines, lines, ""},
+		{"insert into empty", nil, []string{"x", "y"}, "@@ -0,0 +1,2 @@\n+x\n+y\n"},
+		{"delete everything", []string{"x", "y"}, nil, "@@ -1,2 +0,0 @@\n-x\n-y\n"},
+		{"single line", []string{"x"}, []string{"y"}, "@@ -1 +1 @@\n-x\n+y\n"},
+		{
+			"insert with context",
+			lines[:6], []string{"l1", "l2", "l3", "new", "l4", "l5", "l6"},
+			"@@ -1,6 +1,7 @@\n l1\n l2\n l3\n+new\n l4\n l5\n l6\n",
+		},
+		{
+			"delete with context",
+			lines[:10], append(append([]string(nil), lines[:4]...), lines[5:10]...),
+			"@@ -2,7 +2,6 @@\n l2\n l3\n l4\n-l5\n l6\n l7\n l8\n",
+		},
+		{
+			"changes within twice the context share a hunk",
+			lines, replaced(lines, 4, 10),
+			"@@ -2,13 +2,13 @@\n l2\n l3\n l4\n-l5\n+l5'\n l6\n l7\n l8\n l9\n l10\n-l11\n+l11'\n l12\n l13\n l14\n",
+		},
+		{
+			"distant changes get separate hunks",
+			lines, replaced(lines, 1, 15),
+			"@@ -1,5 +1,5 @@\n l1\n-l2\n+l2'\n l3\n l4\n l5\n" +
+				"@@ -13,7 +13,7 @@\n l13\n l14\n l15\n-l16\n+l16'\n l17\n l18\n l19\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tt.want
+			if want != "" {
+				want = "--- a\n+++ b\n" + want
+			}
+			if got := unifiedDiff("a", "b", tt.a, tt.b); got != want {
+				t.Errorf("unifiedDiff =\n%s\nwant\n%s", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,332 @@
+package excelmetadata
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const defaultStructPackage = "models"
+
+// StructOptions configures GenerateStructs
+type StructOptions struct {
+	// PackageName of the generated file, "models" by default
+	PackageName string
+	// HeaderRow is the header row of sheets without tables, 1 by default
+	HeaderRow int
+}
+
+// RowLayout describes the header and inferred column types of a sheet or table
+type RowLayout struct {
+	TypeName string
+	Sheet    string
+	Table    string
+	Ref      string
+	Columns  []ColumnLayout
+}
+
+// ColumnLayout is a single header column and its inferred Go type
+type ColumnLayout struct {
+	Header    string
+	FieldName string
+	GoType    string
+}
+
+// InferRowLayouts derives one RowLayout per table, or per sheet for sheets
+// without tables, from the header row and the values below it
+func InferRowLayouts(metadata *Metadata, headerRow int) []RowLayout {
+	if headerRow <= 0 {
+		headerRow = 1
+	}
+
+	var layouts []RowLayout
+	typeNames := map[string]bool{}
+	for _, sheet := range metadata.Sheets {
+		if len(sheet.Tables) > 0 {
+			for _, tbl := range sheet.Tables {
+				if !tbl.ShowHeaderRow {
+					continue
+				}
+				startCol, startRow, endCol, endRow, err := parseRowsRef(tbl.Range)
+				if err != nil {
+					continue
+				}
+				layout := inferLayout(sheet, metadata.Styles, startRow, startCol, endCol, endRow)
+				if len(layout.Columns) == 0 {
+					continue
+				}
+				layout.TypeName = uniqueIdentifier(goIdentifier(tbl.Name)+"Row", typeNames)
+				layout.Table = tbl.Name
+				layout.Ref = tbl.Range
+				layouts = append(layouts, layout)
+			}
+			continue
+		}
+
+		layout := inferLayout(sheet, metadata.Styles, headerRow, 1, 0, 0)
+		if len(layout.Columns) == 0 {
+			continue
+		}
+		layout.TypeName = uniqueIdentifier(goIdentifier(sheet.Name)+"Row", typeNames)
+		layouts = append(layouts, layout)
+	}
+	return layouts
+}
+
+// GenerateStructs emits gofmt-formatted Go source with a struct per sheet
+// or table, tagged with `xlsx:"Column Name"`, and a Read<Type>s function
+// that loads matching rows of a workbook through ReadRows
+func GenerateStructs(metadata *Metadata, options *StructOptions) ([]byte, error) {
+	if metadata == nil {
+		return nil, fmt.Errorf("metadata is nil")
+	}
+	if options == nil {
+		options = &StructOptions{}
+	}
+	packageName := options.PackageName
+	if packageName == "" {
+		packageName = defaultStructPackage
+	}
+	if !token.IsIdentifier(packageName) {
+		return nil, fmt.Errorf("invalid package name %q", packageName)
+	}
+
+	layouts := InferRowLayouts(metadata, options.HeaderRow)
+	if len(layouts) == 0 {
+		return nil, fmt.Errorf("no header rows found")
+	}
+
+	usesTime := false
+	for _, layout := range layouts {
+		for _, col := range layout.Columns {
+			if col.GoType == "time.Time" {
+				usesTime = true
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by excelmetadata. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\nimport (\n", packageName)
+	if usesTime {
+		buf.WriteString("\"time\"\n\n")
+	}
+	fmt.Fprintf(&buf, "%q\n%q\n)\n", packagePath, excelizePath)
+
+	for _, layout := range layouts {
+		source := fmt.Sprintf("sheet %q", layout.Sheet)
+		if layout.Table != "" {
+			source = fmt.Sprintf("table %q on sheet %q", layout.Table, layout.Sheet)
+		}
+
+		fmt.Fprintf(&buf, "\n// %s is a row of %s.\n", layout.TypeName, source)
+		fmt.Fprintf(&buf, "type %s struct {\n", layout.TypeName)
+		for _, col := range layout.Columns {
+			fmt.Fprintf(&buf, "%s %s `xlsx:%s`\n", col.FieldName, col.GoType, strconv.Quote(col.Header))
+		}
+		buf.WriteString("}\n")
+
+		fmt.Fprintf(&buf, "\n// Read%ss reads the rows of %s starting at %s.\n", layout.TypeName, source, layout.Ref)
+		buf.WriteString("// Cells that fail to parse are reported as excelmetadata.CellErrors.\n")
+		fmt.Fprintf(&buf, "func Read%ss(f *excelize.File) ([]%s, error) {\n", layout.TypeName, layout.TypeName)
+		fmt.Fprintf(&buf, "return excelmetadata.ReadRows[%s](f, %q, %q)\n}\n", layout.TypeName, layout.Sheet, layout.Ref)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format Go source: %w", err)
+	}
+	return src, nil
+}
+
+// inferLayout reads the header at headerRow between startCol and endCol
+// (0 for no limit) and infers column types from the rows below it
+func inferLayout(sheet SheetMetadata, styles map[int]StyleDetails, headerRow, startCol, endCol, endRow int) RowLayout {
+	layout := RowLayout{Sheet: sheet.Name}
+
+	type column struct {
+		index  int
+		header string
+		values []CellMetadata
+	}
+	byCol := map[int]*column{}
+	var order []*column
+
+	for _, cell := range sheet.Cells {
+		col, row, err := excelize.CellNameToCoordinates(cell.Address)
+		if err != nil || row != headerRow || col < startCol || (endCol > 0 && col > endCol) {
+			continue
+		}
+		header := strings.TrimSpace(fmt.Sprint(cell.Value))
+		if cell.Value == nil || header == "" {
+			continue
+		}
+		c := &column{index: col, header: header}
+		byCol[col] = c
+		order = append(order, c)
+	}
+	if len(order) == 0 {
+		return layout
+	}
+	layout.Ref, _ = excelize.CoordinatesToCellName(order[0].index, headerRow)
+
+	for _, cell := range sheet.Cells {
+		col, row, err := excelize.CellNameToCoordinates(cell.Address)
+		if err != nil || row <= headerRow || (endRow > 0 && row > endRow) {
+			continue
+		}
+		if c, ok := byCol[col]; ok {
+			c.values = append(c.values, cell)
+		}
+	}
+
+	fieldNames := map[string]bool{}
+	seenHeaders := map[string]bool{}
+	for _, c := range order {
+		// ReadRows maps a header name to its first column only
+		if seenHeaders[c.header] {
+			continue
+		}
+		seenHeaders[c.header] = true
+		layout.Columns = append(layout.Columns, ColumnLayout{
+			Header:    c.header,
+			FieldName: uniqueIdentifier(goIdentifier(c.header), fieldNames),
+			GoType:    inferGoType(c.values, styles),
+		})
+	}
+	return layout
+}
+
+// inferGoType picks the narrowest Go type that fits every value of a column
+func inferGoType(cells []CellMetadata, styles map[int]StyleDetails) string {
+	allDate, allBool, allInt, allNumber := true, true, true, true
+	seen := false
+
+	for _, cell := range cells {
+		// ReadRows parses unformatted values, so infer the type from them
+		value := strings.TrimSpace(fmt.Sprint(cell.Value))
+		if cell.RawValue != "" {
+			value = strings.TrimSpace(cell.RawValue)
+		}
+		if cell.Value == nil || value == "" {
+			continue
+		}
+		seen = true
+
+		if !isDateFormat(styles[cell.StyleID]) || cell.StyleID == 0 {
+			allDate = false
+		}
+		if cell.Type != excelize.CellTypeBool {
+			allBool = false
+		}
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			allInt = false
+		}
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			allNumber = false
+		}
+	}
+
+	switch {
+	case !seen:
+		return "string"
+	case allDate:
+		return "time.Time"
+	case allBool:
+		return "bool"
+	case allInt:
+		return "int"
+	case allNumber:
+		return "float64"
+	default:
+		return "string"
+	}
+}
+
+// isDateFormat reports whether the number format of a style displays a date
+// or time
+func isDateFormat(style StyleDetails) bool {
+	if style.CustomNumberFormat == "" {
+		numFmt := style.NumberFormat
+		return (numFmt >= 14 && numFmt <= 22) || (numFmt >= 45 && numFmt <= 47)
+	}
+
+	// Look for date and time codes in the section for positive numbers,
+	// skipping quoted text, escaped characters, colors and locales
+	code := strings.ToLower(style.CustomNumberFormat)
+	for i := 0; i < len(code); i++ {
+		switch c := code[i]; c {
+		case ';':
+			return false
+		case '"':
+			if end := strings.IndexByte(code[i+1:], '"'); end >= 0 {
+				i += end + 1
+			} else {
+				return false
+			}
+		case '\\', '_', '*':
+			i++
+		case '[':
+			end := strings.IndexByte(code[i:], ']')
+			if end < 0 {
+				return false
+			}
+			// Elapsed time such as [h] or [mm]
+			if strings.Trim(code[i+1:i+end], "hms") == "" && end > 1 {
+				return true
+			}
+			i += end
+		case 'y', 'm', 'd', 'h', 's':
+			return true
+		}
+	}
+	return false
+}
+
+// commonInitialisms are upper-cased when they form a whole word of an identifier
+var commonInitialisms = map[string]bool{
+	"API": true, "ID": true, "IP": true, "JSON": true, "SKU": true, "SQL": true,
+	"URL": true, "UUID": true, "VAT": true, "XML": true,
+}
+
+// goIdentifier converts a header or sheet name into an exported Go identifier
+func goIdentifier(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var sb strings.Builder
+	for _, word := range words {
+		if upper := strings.ToUpper(word); commonInitialisms[upper] {
+			sb.WriteString(upper)
+			continue
+		}
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		sb.WriteString(string(runes))
+	}
+
+	ident := sb.String()
+	if ident == "" {
+		return "Column"
+	}
+	if first := []rune(ident)[0]; !unicode.IsLetter(first) || !unicode.IsUpper(first) {
+		ident = "X" + ident
+	}
+	return ident
+}
+
+func uniqueIdentifier(ident string, seen map[string]bool) string {
+	candidate := ident
+	for n := 2; seen[candidate]; n++ {
+		candidate = fmt.Sprintf("%s%d", ident, n)
+	}
+	seen[candidate] = true
+	return candidate
+}
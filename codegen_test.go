@@ -0,0 +1,125 @@
+package excelmetadata_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prongbang/excelmetadata"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestRowStructs(t *testing.T) {
+	filename := newWorkbook(t, func(f *excelize.File) {
+		_ = f.SetSheetName("Sheet1", "Orders")
+		dateStyle, _ := f.NewStyle(&excelize.Style{NumFmt: 14})
+		_ = f.SetSheetRow("Orders", "A1", &[]interface{}{"Order ID", "Customer", "Qty", "Unit Price", "Shipped", "Ordered On"})
+		_ = f.SetSheetRow("Orders", "A2", &[]interface{}{1001, "Ann", 2, 9.5, true, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)})
+		_ = f.SetSheetRow("Orders", "A3", &[]interface{}{1002, "Bob", "many", 3.25, false, time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)})
+		_ = f.SetCellStyle("Orders", "F2", "F3", dateStyle)
+		// Custom formats: a localized date, a grouped integer and a number
+		// with quoted text that must not read as a date
+		dueFormat, totalFormat, ratioFormat := "[$-409]d mmm yyyy;@", "#,##0", `[Blue]0.00" pts"`
+		dueStyle, _ := f.NewStyle(&excelize.Style{CustomNumFmt: &dueFormat})
+		totalStyle, _ := f.NewStyle(&excelize.Style{CustomNumFmt: &totalFormat})
+		ratioStyle, _ := f.NewStyle(&excelize.Style{CustomNumFmt: &ratioFormat})
+		_ = f.SetSheetRow("Orders", "G1", &[]interface{}{"Due On", "Total", "Ratio"})
+		_ = f.SetSheetRow("Orders", "G2", &[]interface{}{time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), 12345, 1.5})
+		_ = f.SetSheetRow("Orders", "G3", &[]interface{}{time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC), 678901, 2.25})
+		_ = f.SetCellStyle("Orders", "G2", "G3", dueStyle)
+		_ = f.SetCellStyle("Orders", "H2", "H3", totalStyle)
+		_ = f.SetCellStyle("Orders", "I2", "I3", ratioStyle)
+
+		_, _ = f.NewSheet("Lookups")
+		_ = f.SetSheetRow("Lookups", "B2", &[]interface{}{"Code", "Label"})
+		_ = f.SetSheetRow("Lookups", "B3", &[]interface{}{"A", "Alpha"})
+		_ = f.AddTable("Lookups", &excelize.Table{Range: "B2:C3", Name: "Codes"})
+	})
+	metadata := extract(t, filename)
+
+	layouts := excelmetadata.InferRowLayouts(metadata, 1)
+	if len(layouts) != 2 {
+		t.Fatalf("expected 2 layouts, got %d: %+v", len(layouts), layouts)
+	}
+
+	types := map[string]string{}
+	for _, col := range layouts[0].Columns {
+		types[col.FieldName] = col.GoType
+	}
+	want := map[string]string{
+		"OrderID":   "int",
+		"Customer":  "string",
+		"Qty":       "string",
+		"UnitPrice": "float64",
+		"Shipped":   "bool",
+		"OrderedOn": "time.Time",
+		"DueOn":     "time.Time",
+		"Total":     "int",
+		"Ratio":     "float64",
+	}
+	for field, goType := range want {
+		if types[field] != goType {
+			t.Errorf("field %s has type %q, want %q", field, types[field], goType)
+		}
+	}
+	if layouts[1].TypeName != "CodesRow" || layouts[1].Ref != "B2:C3" {
+		t.Errorf("unexpected table layout %+v", layouts[1])
+	}
+
+	src, err := excelmetadata.GenerateStructs(metadata, &excelmetadata.StructOptions{PackageName: "orders"})
+	if err != nil {
+		t.Fatalf("GenerateStructs() error = %v", err)
+	}
+	for _, want := range []string{"`xlsx:\"Order ID\"`", "func ReadOrdersRows(", "func ReadCodesRows("} {
+		if !bytes.Contains(src, []byte(want)) {
+			t.Errorf("generated source does not contain %s", want)
+		}
+	}
+
+	compileGo(t, src)
+
+	t.Run("read rows", func(t *testing.T) {
+		type order struct {
+			ID        int       `xlsx:"Order ID"`
+			Customer  string    `xlsx:"Customer"`
+			Qty       int       `xlsx:"Qty"`
+			UnitPrice float64   `xlsx:"Unit Price"`
+			Shipped   bool      `xlsx:"Shipped"`
+			OrderedOn time.Time `xlsx:"Ordered On"`
+		}
+
+		f, err := excelize.OpenFile(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			_ = f.Close()
+		}()
+
+		rows, err := excelmetadata.ReadRows[order](f, "Orders", "A1")
+		var cellErrs excelmetadata.CellErrors
+		if !errors.As(err, &cellErrs) || len(cellErrs) != 1 || cellErrs[0].Cell != "C3" {
+			t.Fatalf("expected a single error for C3, got %v", err)
+		}
+		if len(rows) != 2 {
+			t.Fatalf("expected 2 rows, got %d", len(rows))
+		}
+		first := rows[0]
+		if first.ID != 1001 || first.Customer != "Ann" || first.Qty != 2 || first.UnitPrice != 9.5 || !first.Shipped {
+			t.Errorf("unexpected first row %+v", first)
+		}
+		if !first.OrderedOn.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("OrderedOn = %v", first.OrderedOn)
+		}
+
+		type code struct {
+			Code  string `xlsx:"Code"`
+			Label string `xlsx:"Label"`
+		}
+		codes, err := excelmetadata.ReadRows[code](f, "Lookups", "B2:C3")
+		if err != nil || len(codes) != 1 || codes[0].Label != "Alpha" {
+			t.Errorf("ReadRows() = %+v, %v", codes, err)
+		}
+	})
+}
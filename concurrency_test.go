@@ -0,0 +1,93 @@
+package excelmetadata_test
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prongbang/excelmetadata"
+	"github.com/xuri/excelize/v2"
+)
+
+// Run with -race to check that concurrent sheet extraction is data race free
+func TestConcurrentExtraction(t *testing.T) {
+	filename := newWorkbook(t, func(f *excelize.File) {
+		for i := 0; i < 12; i++ {
+			sheet := fmt.Sprintf("Sheet%d", i+1)
+			if i > 0 {
+				if _, err := f.NewSheet(sheet); err != nil {
+					t.Fatal(err)
+				}
+			}
+			style, _ := f.NewStyle(&excelize.Style{Font: &excelize.Font{Size: float64(8 + i)}})
+			for row := 1; row <= 40; row++ {
+				for col := 1; col <= 6; col++ {
+					cell, _ := excelize.CoordinatesToCellName(col, row)
+					_ = f.SetCellValue(sheet, cell, fmt.Sprintf("%s-%d-%d", sheet, row, col))
+				}
+			}
+			_ = f.SetCellStyle(sheet, "A1", "F1", style)
+			_ = f.SetCellFormula(sheet, "G1", "LEN(A1)")
+			_ = f.MergeCell(sheet, "H1", "I2")
+			_ = f.AddComment(sheet, excelize.Comment{Cell: "A2", Author: "QA", Text: sheet})
+			if i%3 == 0 {
+				_ = f.SetSheetVisible(sheet, false)
+			}
+		}
+	})
+
+	t.Run("matches sequential", func(t *testing.T) {
+		extractWith := func(concurrency int) *excelmetadata.Metadata {
+			options := excelmetadata.DefaultOptions()
+			options.Concurrency = concurrency
+			extractor, err := excelmetadata.New(filename, options)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer extractor.Close()
+
+			metadata, err := extractor.Extract()
+			if err != nil {
+				t.Fatal(err)
+			}
+			metadata.ExtractedAt = time.Time{}
+			return metadata
+		}
+
+		sequential := extractWith(0)
+		if len(sequential.Sheets) != 12 || len(sequential.Styles) != 12 {
+			t.Fatalf("sequential extraction has %d sheets and %d styles, want 12 and 12", len(sequential.Sheets), len(sequential.Styles))
+		}
+		for _, concurrency := range []int{2, 4, 32} {
+			for run := 0; run < 3; run++ {
+				if got := extractWith(concurrency); !reflect.DeepEqual(got, sequential) {
+					t.Fatalf("Concurrency=%d run %d differs from sequential extraction", concurrency, run)
+				}
+			}
+		}
+	})
+
+	t.Run("separate extractors", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				options := excelmetadata.DefaultOptions()
+				options.Concurrency = 4
+				extractor, err := excelmetadata.New(filename, options)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				defer extractor.Close()
+				if _, err := extractor.Extract(); err != nil {
+					t.Error(err)
+				}
+			}()
+		}
+		wg.Wait()
+	})
+}
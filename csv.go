@@ -0,0 +1,266 @@
+package excelmetadata
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// CSVOptions configures the CSV export of cell data
+type CSVOptions struct {
+	// Raw writes unformatted cell values instead of the formatted ones
+	Raw bool
+	// Formulas writes the formula text, prefixed with "=", for formula cells
+	Formulas bool
+	// FillMerged repeats the top-left value of a merged range in every cell
+	// of the range
+	FillMerged bool
+	// HeaderRow is the 1-based row written first; rows above it are
+	// skipped. Zero exports from the first row of the used range without a
+	// header.
+	HeaderRow int
+	// Comma is the field delimiter, ',' when zero
+	Comma rune
+	// QuoteAll quotes every field instead of only those that need it
+	QuoteAll bool
+	// UseCRLF ends lines with \r\n instead of \n
+	UseCRLF bool
+}
+
+// WriteSheetCSV writes the cells of one sheet as CSV. The grid starts at
+// the top left cell of the used range, or of the extracted Range when that
+// lies inside it, and runs to the last row and column holding a cell;
+// empty rows inside the grid are kept so line numbers follow worksheet rows.
+func WriteSheetCSV(w io.Writer, sheet SheetMetadata, options *CSVOptions) error {
+	options = csvDefaults(options)
+	cw := newCSVWriter(w, options)
+	for _, record := range sheetRecords(sheet, options) {
+		if err := cw.write(record); err != nil {
+			return err
+		}
+	}
+	return cw.flush()
+}
+
+// WriteCSV writes the cells of all sheets to a single CSV with the sheet
+// name as first column. When HeaderRow is set, the header of the first
+// sheet is written once under a "Sheet" column and the header rows of the
+// other sheets are skipped.
+func WriteCSV(w io.Writer, metadata *Metadata, options *CSVOptions) error {
+	options = csvDefaults(options)
+	cw := newCSVWriter(w, options)
+	wroteHeader := false
+	for _, sheet := range metadata.Sheets {
+		for i, record := range sheetRecords(sheet, options) {
+			if options.HeaderRow > 0 && i == 0 {
+				if wroteHeader {
+					continue
+				}
+				wroteHeader = true
+				record = append([]string{"Sheet"}, record...)
+			} else {
+				record = append([]string{sheet.Name}, record...)
+			}
+			if err := cw.write(record); err != nil {
+				return err
+			}
+		}
+	}
+	return cw.flush()
+}
+
+// ExportCSV writes one <sheet>.csv file per sheet into dir and returns the
+// paths of the written files
+func ExportCSV(metadata *Metadata, dir string, options *CSVOptions) ([]string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	used := map[string]bool{}
+	for _, sheet := range metadata.Sheets {
+		name := csvFileName(sheet.Name)
+		for i := 2; used[strings.ToLower(name)]; i++ {
+			name = fmt.Sprintf("%s_%d", csvFileName(sheet.Name), i)
+		}
+		used[strings.ToLower(name)] = true
+
+		path := filepath.Join(dir, name+".csv")
+		f, err := os.Create(path)
+		if err != nil {
+			return paths, err
+		}
+		if err := WriteSheetCSV(f, sheet, options); err != nil {
+			_ = f.Close()
+			return paths, fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		if err := f.Close(); err != nil {
+			return paths, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+func csvDefaults(options *CSVOptions) *CSVOptions {
+	if options == nil {
+		options = &CSVOptions{}
+	}
+	if options.Comma == 0 {
+		opts := *options
+		opts.Comma = ','
+		options = &opts
+	}
+	return options
+}
+
+// sheetRecords lays the sheet's cells out as rows of fields, starting at the
+// top left cell of the used range clipped to the extracted range
+func sheetRecords(sheet SheetMetadata, options *CSVOptions) [][]string {
+	firstRow, firstCol := 1, 1
+	if col, row, err := excelize.CellNameToCoordinates(sheet.Dimensions.StartCell); err == nil {
+		firstRow, firstCol = row, col
+	}
+	if from, _, _ := strings.Cut(sheet.Range, ":"); from != "" {
+		if col, row, err := excelize.CellNameToCoordinates(from); err == nil {
+			firstRow, firstCol = max(firstRow, row), max(firstCol, col)
+		}
+	}
+
+	values := map[[2]int]string{}
+	lastRow, lastCol := 0, 0
+	for _, cell := range sheet.Cells {
+		col, row, err := excelize.CellNameToCoordinates(cell.Address)
+		if err != nil {
+			continue
+		}
+		values[[2]int{row, col}] = csvValue(cell, options)
+		firstRow, firstCol = min(firstRow, row), min(firstCol, col)
+		lastRow, lastCol = max(lastRow, row), max(lastCol, col)
+	}
+
+	if options.FillMerged {
+		for _, mc := range sheet.MergedCells {
+			c1, r1, err1 := excelize.CellNameToCoordinates(mc.StartCell)
+			c2, r2, err2 := excelize.CellNameToCoordinates(mc.EndCell)
+			if err1 != nil || err2 != nil {
+				continue
+			}
+			value, ok := values[[2]int{r1, c1}]
+			if !ok {
+				value = mc.Value
+			}
+			for r := r1; r <= r2; r++ {
+				for c := c1; c <= c2; c++ {
+					values[[2]int{r, c}] = value
+				}
+			}
+			firstRow, firstCol = min(firstRow, r1), min(firstCol, c1)
+			lastRow, lastCol = max(lastRow, r2), max(lastCol, c2)
+		}
+	}
+
+	if options.HeaderRow > 0 {
+		firstRow = options.HeaderRow
+	}
+
+	var records [][]string
+	for row := firstRow; row <= lastRow; row++ {
+		record := make([]string, lastCol-firstCol+1)
+		for col := firstCol; col <= lastCol; col++ {
+			record[col-firstCol] = values[[2]int{row, col}]
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func csvValue(cell CellMetadata, options *CSVOptions) string {
+	if options.Formulas && cell.Formula != "" {
+		return "=" + cell.Formula
+	}
+	if options.Raw && cell.RawValue != "" {
+		return cell.RawValue
+	}
+	return reportValue(cell.Value)
+}
+
+// csvFileName replaces characters that are not portable in file names
+func csvFileName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '*', '?', '"', '<', '>', '|':
+			return '_'
+		}
+		if r < 0x20 {
+			return '_'
+		}
+		return r
+	}, name)
+	name = strings.Trim(name, ". ")
+	if name == "" {
+		name = "sheet"
+	}
+	return name
+}
+
+// csvWriter is an encoding/csv style writer that can also quote every field
+type csvWriter struct {
+	w       *bufio.Writer
+	comma   rune
+	quote   bool
+	newline string
+}
+
+func newCSVWriter(w io.Writer, options *CSVOptions) *csvWriter {
+	cw := &csvWriter{w: bufio.NewWriter(w), comma: options.Comma, quote: options.QuoteAll, newline: "\n"}
+	if options.UseCRLF {
+		cw.newline = "\r\n"
+	}
+	return cw
+}
+
+func (cw *csvWriter) write(record []string) error {
+	if cw.comma == '"' || cw.comma == '\r' || cw.comma == '\n' || !utf8.ValidRune(cw.comma) {
+		return fmt.Errorf("invalid CSV delimiter %q", cw.comma)
+	}
+	for i, field := range record {
+		if i > 0 {
+			if _, err := cw.w.WriteRune(cw.comma); err != nil {
+				return err
+			}
+		}
+		if !cw.quote && !cw.needsQuotes(field) {
+			if _, err := cw.w.WriteString(field); err != nil {
+				return err
+			}
+			continue
+		}
+		field = strings.ReplaceAll(field, `"`, `""`)
+		if _, err := cw.w.WriteString(`"` + field + `"`); err != nil {
+			return err
+		}
+	}
+	_, err := cw.w.WriteString(cw.newline)
+	return err
+}
+
+func (cw *csvWriter) needsQuotes(field string) bool {
+	if field == "" {
+		return false
+	}
+	if strings.ContainsRune(field, cw.comma) || strings.ContainsAny(field, "\"\r\n") {
+		return true
+	}
+	return field[0] == ' ' || field[0] == '\t'
+}
+
+func (cw *csvWriter) flush() error {
+	return cw.w.Flush()
+}
@@ -0,0 +1,136 @@
+package excelmetadata_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prongbang/excelmetadata"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestCSV(t *testing.T) {
+	file := newWorkbook(t, func(f *excelize.File) {
+		percent, _ := f.NewStyle(&excelize.Style{NumFmt: 10})
+		_ = f.SetCellValue("Sheet1", "A1", "Title")
+		_ = f.SetCellValue("Sheet1", "A2", "Region")
+		_ = f.SetCellValue("Sheet1", "B2", "Share")
+		_ = f.SetCellValue("Sheet1", "C2", "Note")
+		_ = f.SetCellValue("Sheet1", "A3", "North")
+		_ = f.SetCellValue("Sheet1", "B3", 0.25)
+		_ = f.SetCellStyle("Sheet1", "B3", "B3", percent)
+		_ = f.SetCellValue("Sheet1", "C3", "a, \"b\"")
+		_ = f.SetCellValue("Sheet1", "A4", "South")
+		_ = f.MergeCell("Sheet1", "A4", "A5")
+		_ = f.SetCellValue("Sheet1", "B4", 0.5)
+		_ = f.SetCellFormula("Sheet1", "B4", "B3*2")
+		_, _ = f.NewSheet("Q1|Q2")
+		// Data away from A1 is exported from its first row and column
+		_ = f.SetCellValue("Q1|Q2", "C2", "x")
+		_ = f.SetCellValue("Q1|Q2", "D3", "y")
+	})
+	metadata := extract(t, file)
+
+	tests := []struct {
+		name    string
+		sheet   int
+		all     bool
+		options *excelmetadata.CSVOptions
+		want    string
+	}{
+		{
+			name:    "formatted",
+			options: nil,
+			want:    "Title,,\nRegion,Share,Note\nNorth,25.00%,\"a, \"\"b\"\"\"\nSouth,0.5,\n",
+		},
+		{
+			name:    "raw with header row",
+			options: &excelmetadata.CSVOptions{Raw: true, HeaderRow: 2},
+			want:    "Region,Share,Note\nNorth,0.25,\"a, \"\"b\"\"\"\nSouth,0.5,\n",
+		},
+		{
+			name:    "formulas and fill merged",
+			options: &excelmetadata.CSVOptions{Formulas: true, FillMerged: true, HeaderRow: 4},
+			want:    "South,=B3*2,\nSouth,,\n",
+		},
+		{
+			name:    "delimiter and quoting",
+			options: &excelmetadata.CSVOptions{Comma: ';', QuoteAll: true, UseCRLF: true, HeaderRow: 3},
+			want:    "\"North\";\"25.00%\";\"a, \"\"b\"\"\"\r\n\"South\";\"0.5\";\"\"\r\n",
+		},
+		{
+			name:  "data away from A1",
+			sheet: 1,
+			want:  "x,\n,y\n",
+		},
+		{
+			name:    "all sheets in one file",
+			all:     true,
+			options: &excelmetadata.CSVOptions{HeaderRow: 2},
+			want: "Sheet,Region,Share,Note\n" +
+				"Sheet1,North,25.00%,\"a, \"\"b\"\"\"\n" +
+				"Sheet1,South,0.5,\n" +
+				"Q1|Q2,,y\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			var err error
+			if tt.all {
+				err = excelmetadata.WriteCSV(&buf, metadata, tt.options)
+			} else {
+				err = excelmetadata.WriteSheetCSV(&buf, metadata.Sheets[tt.sheet], tt.options)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("CSV =\n%q\nwant\n%q", buf.String(), tt.want)
+			}
+		})
+	}
+
+	t.Run("export", func(t *testing.T) {
+		dir := t.TempDir()
+		paths, err := excelmetadata.ExportCSV(metadata, dir, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := []string{filepath.Join(dir, "Sheet1.csv"), filepath.Join(dir, "Q1_Q2.csv")}
+		if len(paths) != len(want) || paths[0] != want[0] || paths[1] != want[1] {
+			t.Fatalf("ExportCSV() = %v, want %v", paths, want)
+		}
+		data, err := os.ReadFile(paths[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != "x,\n,y\n" {
+			t.Errorf("Q1_Q2.csv = %q", data)
+		}
+	})
+
+	// A range starts the grid at its first cell, but not before the used range
+	for _, tt := range []struct{ ref, want string }{
+		{"Sheet1!B2:C3", "Share,Note\n25.00%,\"a, \"\"b\"\"\"\n"},
+		{"Q1|Q2!A1:D3", "x,\n,y\n"},
+	} {
+		t.Run("range "+tt.ref, func(t *testing.T) {
+			ranged := extractWithOptions(t, file, func(o *excelmetadata.Options) { o.Ranges = []string{tt.ref} })
+			var buf bytes.Buffer
+			for _, sheet := range ranged.Sheets {
+				if sheet.Range == "" {
+					continue
+				}
+				if err := excelmetadata.WriteSheetCSV(&buf, sheet, nil); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if buf.String() != tt.want {
+				t.Errorf("CSV =\n%q\nwant\n%q", buf.String(), tt.want)
+			}
+		})
+	}
+}
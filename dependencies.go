@@ -0,0 +1,479 @@
+package excelmetadata
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// maxNameDepth bounds how far defined names referring to other names are
+// followed
+const maxNameDepth = 8
+
+// DependencyEdge links a precedent to the formula cell using it. From is a
+// cell, a range such as Sheet1!A1:A10, or a reference that cannot be
+// resolved to cells (an external or structured reference, or a name with a
+// constant value). Via names the defined name the reference went through.
+type DependencyEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Via  string `json:"via,omitempty"`
+}
+
+// DependencyGraph links the formula cells of a workbook to the cells and
+// ranges they read. Nodes are written as sheet qualified references, e.g.
+// Sheet1!B2 or 'Q1 Data'!A1:A10.
+type DependencyGraph struct {
+	Nodes []string         `json:"nodes"`
+	Edges []DependencyEdge `json:"edges"`
+	// Cycles lists the groups of formula cells that depend on each other
+	Cycles [][]string `json:"cycles,omitempty"`
+	// Unparsed lists the formula cells whose formula could not be parsed
+	Unparsed []string `json:"unparsed,omitempty"`
+
+	nodes    map[string]bool
+	edges    map[DependencyEdge]bool
+	formulas map[string]bool
+	// bounds holds the sheet and range of every node resolved to cells
+	bounds map[string]graphBounds
+	// order is the position of every node in Nodes
+	order map[string]int
+	// sheetFormulas indexes the formula cells of each sheet, keyed by the
+	// lower-cased sheet name and sorted by row, then column
+	sheetFormulas map[string][]graphCell
+	// precedents holds the edges into each formula cell, and dependents the
+	// formula cells reading each formula cell, directly or through a range
+	precedents map[string][]DependencyEdge
+	dependents map[string][]string
+}
+
+// graphCell is a formula cell in the per-sheet index
+type graphCell struct {
+	row, col int
+	node     string
+}
+
+type graphBounds struct {
+	sheet string
+	cells cellRange
+}
+
+// NewDependencyGraph builds the dependency graph of the extracted formulas,
+// resolving defined names to the ranges they refer to
+func NewDependencyGraph(metadata *Metadata) *DependencyGraph {
+	g := &DependencyGraph{
+		Nodes:    []string{},
+		Edges:    []DependencyEdge{},
+		nodes:    make(map[string]bool),
+		edges:    make(map[DependencyEdge]bool),
+		formulas: make(map[string]bool),
+		bounds:   make(map[string]graphBounds),
+		order:    make(map[string]int),
+	}
+	b := newGraphBuilder(g, metadata)
+
+	for _, sheet := range metadata.Sheets {
+		for _, cell := range sheet.Cells {
+			if cell.Formula == "" {
+				continue
+			}
+			to := g.cellNode(sheet.Name, cell.Address)
+			g.formulas[to] = true
+
+			ast, err := ParseFormula(cell.Formula)
+			if err != nil {
+				g.Unparsed = append(g.Unparsed, to)
+				continue
+			}
+			ast.Walk(func(n *FormulaNode) bool {
+				if n.Kind == NodeReference {
+					b.link(sheet.Name, n.Value, to, "", 0)
+				}
+				return true
+			})
+		}
+	}
+
+	g.index()
+	g.Cycles = g.findCycles()
+	return g
+}
+
+// graphBuilder resolves references while building a graph
+type graphBuilder struct {
+	graph    *DependencyGraph
+	metadata *Metadata
+	// sheets and names map lower-cased sheet and defined names to the
+	// sheet name as spelled in the workbook and the defined names
+	sheets map[string]string
+	names  map[string][]DefinedName
+}
+
+func newGraphBuilder(g *DependencyGraph, metadata *Metadata) *graphBuilder {
+	b := &graphBuilder{
+		graph:    g,
+		metadata: metadata,
+		sheets:   make(map[string]string),
+		names:    make(map[string][]DefinedName),
+	}
+	for _, sheet := range metadata.Sheets {
+		key := strings.ToLower(sheet.Name)
+		if _, ok := b.sheets[key]; !ok {
+			b.sheets[key] = sheet.Name
+		}
+	}
+	for _, dn := range metadata.DefinedNames {
+		key := strings.ToLower(dn.Name)
+		b.names[key] = append(b.names[key], dn)
+	}
+	return b
+}
+
+// link adds an edge from the reference ref, read by a formula on sheet, to
+// the formula cell to
+func (b *graphBuilder) link(sheet, ref, to, via string, depth int) {
+	g := b.graph
+	refSheet, address := sheet, ref
+	if i := strings.LastIndex(ref, "!"); i >= 0 {
+		refSheet, address = ref[:i], ref[i+1:]
+	}
+
+	if cells, err := parseCellRange(address); err == nil {
+		g.addEdge(DependencyEdge{From: g.rangeNode(b.sheetName(refSheet), address, cells), To: to, Via: via})
+		return
+	}
+
+	if dn, ok := b.definedName(sheet, ref); ok && depth < maxNameDepth {
+		scope := sheet
+		if dn.Scope != "" && dn.Scope != "Workbook" {
+			scope = dn.Scope
+		}
+		linked := false
+		if ast, err := ParseFormula(dn.RefersTo); err == nil {
+			ast.Walk(func(n *FormulaNode) bool {
+				if n.Kind == NodeReference {
+					b.link(scope, n.Value, to, dn.Name, depth+1)
+					linked = true
+				}
+				return true
+			})
+		}
+		if !linked {
+			g.addEdge(DependencyEdge{From: dn.Name, To: to, Via: dn.Name})
+		}
+		return
+	}
+
+	g.addNode(quoteReference(ref))
+	g.addEdge(DependencyEdge{From: quoteReference(ref), To: to, Via: via})
+}
+
+// sheetName returns the name of the sheet as spelled in the workbook
+func (b *graphBuilder) sheetName(name string) string {
+	if sheet, ok := b.sheets[strings.ToLower(name)]; ok {
+		return sheet
+	}
+	return name
+}
+
+// definedName looks a name up in the scope of sheet, then in the workbook
+func (b *graphBuilder) definedName(sheet, name string) (DefinedName, bool) {
+	var global *DefinedName
+	names := b.names[strings.ToLower(name)]
+	for i, dn := range names {
+		if strings.EqualFold(dn.Scope, sheet) {
+			return dn, true
+		}
+		if dn.Scope == "" || dn.Scope == "Workbook" {
+			global = &names[i]
+		}
+	}
+	if global != nil {
+		return *global, true
+	}
+	return DefinedName{}, false
+}
+
+func (g *DependencyGraph) addNode(node string) {
+	if !g.nodes[node] {
+		g.nodes[node] = true
+		g.order[node] = len(g.Nodes)
+		g.Nodes = append(g.Nodes, node)
+	}
+}
+
+func (g *DependencyGraph) addEdge(edge DependencyEdge) {
+	g.addNode(edge.From)
+	g.addNode(edge.To)
+	if !g.edges[edge] {
+		g.edges[edge] = true
+		g.Edges = append(g.Edges, edge)
+	}
+}
+
+func (g *DependencyGraph) cellNode(sheet, address string) string {
+	cells, _ := parseCellRange(address)
+	return g.rangeNode(sheet, address, cells)
+}
+
+func (g *DependencyGraph) rangeNode(sheet, address string, cells cellRange) string {
+	node := quoteReference(sheet + "!" + strings.ToUpper(strings.ReplaceAll(address, "$", "")))
+	g.bounds[node] = graphBounds{sheet: sheet, cells: cells}
+	g.addNode(node)
+	return node
+}
+
+// resolve parses a sheet qualified reference given to a query
+func (g *DependencyGraph) resolve(ref string) (graphBounds, bool) {
+	if bounds, ok := g.bounds[ref]; ok {
+		return bounds, true
+	}
+	i := strings.LastIndex(ref, "!")
+	if i <= 0 {
+		return graphBounds{}, false
+	}
+	sheet := ref[:i]
+	if len(sheet) > 1 && strings.HasPrefix(sheet, "'") && strings.HasSuffix(sheet, "'") {
+		sheet = strings.ReplaceAll(sheet[1:len(sheet)-1], "''", "'")
+	}
+	cells, err := parseCellRange(ref[i+1:])
+	if err != nil {
+		return graphBounds{}, false
+	}
+	return graphBounds{sheet: sheet, cells: cells}, true
+}
+
+// overlaps reports whether two resolved nodes share a cell
+func (a graphBounds) overlaps(b graphBounds) bool {
+	return strings.EqualFold(a.sheet, b.sheet) &&
+		a.cells.fromCol <= b.cells.toCol && b.cells.fromCol <= a.cells.toCol &&
+		a.cells.fromRow <= b.cells.toRow && b.cells.fromRow <= a.cells.toRow
+}
+
+// index builds the per-sheet formula index and the adjacency of the graph
+func (g *DependencyGraph) index() {
+	g.sheetFormulas = make(map[string][]graphCell)
+	for _, node := range g.Nodes {
+		if g.formulas[node] {
+			bounds := g.bounds[node]
+			key := strings.ToLower(bounds.sheet)
+			g.sheetFormulas[key] = append(g.sheetFormulas[key], graphCell{row: bounds.cells.fromRow, col: bounds.cells.fromCol, node: node})
+		}
+	}
+	for _, cells := range g.sheetFormulas {
+		sort.Slice(cells, func(i, j int) bool {
+			if cells[i].row != cells[j].row {
+				return cells[i].row < cells[j].row
+			}
+			return cells[i].col < cells[j].col
+		})
+	}
+
+	g.precedents = make(map[string][]DependencyEdge)
+	g.dependents = make(map[string][]string)
+	for _, edge := range g.Edges {
+		g.precedents[edge.To] = append(g.precedents[edge.To], edge)
+		for _, cell := range g.formulasIn(edge.From) {
+			g.dependents[cell] = append(g.dependents[cell], edge.To)
+		}
+	}
+}
+
+// formulasIn returns the formula cells inside a node, in node order, or
+// the node itself when it is a formula cell
+func (g *DependencyGraph) formulasIn(node string) []string {
+	if g.formulas[node] {
+		return []string{node}
+	}
+	bounds, ok := g.resolve(node)
+	if !ok {
+		return nil
+	}
+	index := g.sheetFormulas[strings.ToLower(bounds.sheet)]
+	var cells []string
+	first := sort.Search(len(index), func(i int) bool { return index[i].row >= bounds.cells.fromRow })
+	for _, cell := range index[first:] {
+		if cell.row > bounds.cells.toRow {
+			break
+		}
+		if cell.col >= bounds.cells.fromCol && cell.col <= bounds.cells.toCol {
+			cells = append(cells, cell.node)
+		}
+	}
+	sort.Slice(cells, func(i, j int) bool { return g.order[cells[i]] < g.order[cells[j]] })
+	return cells
+}
+
+// Precedents returns the cells and ranges read by the formulas in ref, a
+// sheet qualified cell or range. With transitive set it follows the
+// formulas inside those precedents too.
+func (g *DependencyGraph) Precedents(ref string, transitive bool) []string {
+	var result []string
+	seen := make(map[string]bool)
+	queue := g.formulasIn(ref)
+	visited := make(map[string]bool)
+	for len(queue) > 0 {
+		cell := queue[0]
+		queue = queue[1:]
+		if visited[cell] {
+			continue
+		}
+		visited[cell] = true
+		for _, edge := range g.precedents[cell] {
+			if seen[edge.From] {
+				continue
+			}
+			seen[edge.From] = true
+			result = append(result, edge.From)
+			if transitive {
+				queue = append(queue, g.formulasIn(edge.From)...)
+			}
+		}
+	}
+	return result
+}
+
+// Dependents returns the formula cells reading ref, a sheet qualified cell
+// or range, or a reference the graph could not resolve. With transitive set
+// it follows the cells depending on those formulas too.
+func (g *DependencyGraph) Dependents(ref string, transitive bool) []string {
+	var (
+		result []string
+		queue  []string
+		seen   = make(map[string]bool)
+	)
+	add := func(cell string) {
+		if !seen[cell] {
+			seen[cell] = true
+			result = append(result, cell)
+			if transitive {
+				queue = append(queue, cell)
+			}
+		}
+	}
+
+	// ref may be any cell or range, so every edge is checked once; the
+	// formula cells found after that are followed through the adjacency
+	bounds, resolved := g.resolve(ref)
+	for _, edge := range g.Edges {
+		from, ok := g.bounds[edge.From]
+		if edge.From == ref || resolved && ok && from.overlaps(bounds) {
+			add(edge.To)
+		}
+	}
+	for len(queue) > 0 {
+		cell := queue[0]
+		queue = queue[1:]
+		for _, dependent := range g.dependents[cell] {
+			add(dependent)
+		}
+	}
+	return result
+}
+
+// findCycles returns the strongly connected groups of formula cells, using
+// Tarjan's algorithm over the cell to cell edges
+func (g *DependencyGraph) findCycles() [][]string {
+	adjacent := g.dependents
+
+	var (
+		cycles  [][]string
+		stack   []string
+		counter int
+		index   = make(map[string]int)
+		low     = make(map[string]int)
+		onStack = make(map[string]bool)
+		order   = g.order
+	)
+
+	var connect func(string)
+	connect = func(v string) {
+		counter++
+		index[v], low[v] = counter, counter
+		stack = append(stack, v)
+		onStack[v] = true
+
+		selfLoop := false
+		for _, w := range adjacent[v] {
+			if w == v {
+				selfLoop = true
+			}
+			if index[w] == 0 {
+				connect(w)
+				low[v] = min(low[v], low[w])
+			} else if onStack[w] {
+				low[v] = min(low[v], index[w])
+			}
+		}
+
+		if low[v] == index[v] {
+			var component []string
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				component = append(component, w)
+				if w == v {
+					break
+				}
+			}
+			if len(component) > 1 || selfLoop {
+				sort.Slice(component, func(i, j int) bool { return order[component[i]] < order[component[j]] })
+				cycles = append(cycles, component)
+			}
+		}
+	}
+	for _, node := range g.Nodes {
+		if g.formulas[node] && index[node] == 0 {
+			connect(node)
+		}
+	}
+
+	sort.SliceStable(cycles, func(i, j int) bool { return order[cycles[i][0]] < order[cycles[j][0]] })
+	return cycles
+}
+
+// WriteDOT writes the graph in Graphviz DOT format. Ranges are drawn as
+// boxes and cells in circular references in red.
+func (g *DependencyGraph) WriteDOT(w io.Writer) error {
+	inCycle := make(map[string]bool)
+	for _, cycle := range g.Cycles {
+		for _, node := range cycle {
+			inCycle[node] = true
+		}
+	}
+	quote := func(s string) string {
+		return `"` + strings.ReplaceAll(strings.ReplaceAll(s, `\`, `\\`), `"`, `\"`) + `"`
+	}
+
+	var sb strings.Builder
+	sb.WriteString("digraph dependencies {\n\trankdir=LR;\n\tnode [shape=ellipse];\n")
+	for _, node := range g.Nodes {
+		var attrs []string
+		if bounds, ok := g.bounds[node]; !ok || bounds.cells.fromCol != bounds.cells.toCol || bounds.cells.fromRow != bounds.cells.toRow {
+			attrs = append(attrs, "shape=box")
+		}
+		if inCycle[node] {
+			attrs = append(attrs, "color=red", "fontcolor=red")
+		}
+		sb.WriteString("\t" + quote(node))
+		if len(attrs) > 0 {
+			sb.WriteString(" [" + strings.Join(attrs, ", ") + "]")
+		}
+		sb.WriteString(";\n")
+	}
+	for _, edge := range g.Edges {
+		sb.WriteString("\t" + quote(edge.From) + " -> " + quote(edge.To))
+		if edge.Via != "" {
+			sb.WriteString(" [label=" + quote(edge.Via) + "]")
+		}
+		sb.WriteString(";\n")
+	}
+	sb.WriteString("}\n")
+
+	if _, err := io.WriteString(w, sb.String()); err != nil {
+		return fmt.Errorf("failed to write DOT: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package excelmetadata_test
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/prongbang/excelmetadata"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestDependencyGraph(t *testing.T) {
+	metadata := extract(t, newWorkbook(t, func(f *excelize.File) {
+		for _, sheet := range []string{"Inputs", "Q1 Data"} {
+			if _, err := f.NewSheet(sheet); err != nil {
+				t.Fatal(err)
+			}
+		}
+		formula := func(sheet, cell, formula string) {
+			_ = f.SetCellValue(sheet, cell, 1)
+			_ = f.SetCellFormula(sheet, cell, formula)
+		}
+
+		_ = f.SetCellValue("Inputs", "B1", 0.2)
+		_ = f.SetDefinedName(&excelize.DefinedName{Name: "Rate", RefersTo: "Inputs!$B$1"})
+
+		_ = f.SetCellValue("Sheet1", "A1", 1)
+		_ = f.SetCellValue("Sheet1", "A2", 2)
+		formula("Sheet1", "A3", "SUM(A1:A2)")
+		formula("Sheet1", "B1", "A3*Rate")
+		formula("Q1 Data", "C1", "Sheet1!B1+1")
+
+		// D1 and E1 depend on each other, F1 on itself
+		formula("Sheet1", "D1", "E1+1")
+		formula("Sheet1", "E1", "D1*2")
+		formula("Sheet1", "F1", "SUM(F1:F2)")
+	}))
+	g := excelmetadata.NewDependencyGraph(metadata)
+
+	// Cells are read row by row
+	wantEdges := []excelmetadata.DependencyEdge{
+		{From: "Sheet1!A3", To: "Sheet1!B1"},
+		{From: "Inputs!B1", To: "Sheet1!B1", Via: "Rate"},
+		{From: "Sheet1!E1", To: "Sheet1!D1"},
+		{From: "Sheet1!D1", To: "Sheet1!E1"},
+		{From: "Sheet1!F1:F2", To: "Sheet1!F1"},
+		{From: "Sheet1!A1:A2", To: "Sheet1!A3"},
+		{From: "Sheet1!B1", To: "'Q1 Data'!C1"},
+	}
+	if !reflect.DeepEqual(g.Edges, wantEdges) {
+		t.Errorf("edges = %+v\nwant %+v", g.Edges, wantEdges)
+	}
+
+	wantCycles := [][]string{{"Sheet1!D1", "Sheet1!E1"}, {"Sheet1!F1"}}
+	if !reflect.DeepEqual(g.Cycles, wantCycles) {
+		t.Errorf("cycles = %v, want %v", g.Cycles, wantCycles)
+	}
+
+	tests := []struct {
+		name string
+		got  []string
+		want []string
+	}{
+		{"precedents", g.Precedents("Sheet1!B1", false), []string{"Sheet1!A3", "Inputs!B1"}},
+		{"transitive precedents", g.Precedents("'Q1 Data'!C1", true), []string{"Sheet1!B1", "Sheet1!A3", "Inputs!B1", "Sheet1!A1:A2"}},
+		{"dependents of a cell inside a range", g.Dependents("Sheet1!A2", false), []string{"Sheet1!A3"}},
+		{"transitive dependents", g.Dependents("Sheet1!A2", true), []string{"Sheet1!A3", "Sheet1!B1", "'Q1 Data'!C1"}},
+		{"dependents through a name", g.Dependents("inputs!b1", false), []string{"Sheet1!B1"}},
+		{"no dependents", g.Dependents("Inputs!Z9", true), nil},
+	}
+	for _, tt := range tests {
+		if !reflect.DeepEqual(tt.got, tt.want) {
+			t.Errorf("%s = %q, want %q", tt.name, tt.got, tt.want)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := g.WriteDOT(&buf); err != nil {
+		t.Fatal(err)
+	}
+	dot := buf.String()
+	for _, want := range []string{
+		"digraph dependencies {",
+		`"Sheet1!A1:A2" [shape=box];`,
+		`"Sheet1!D1" [color=red, fontcolor=red];`,
+		`"Inputs!B1" -> "Sheet1!B1" [label="Rate"];`,
+		`"Sheet1!B1" -> "'Q1 Data'!C1";`,
+	} {
+		if !strings.Contains(dot, want) {
+			t.Errorf("DOT output misses %s:\n%s", want, dot)
+		}
+	}
+}
+
+func TestDependencyGraphLongChain(t *testing.T) {
+	// A chain of formulas, each reading the one above, plus a total over
+	// the whole column: quadratic graph building makes this take minutes
+	const n = 20000
+	sheet := excelmetadata.SheetMetadata{Name: "Sheet1"}
+	for i := 2; i <= n; i++ {
+		sheet.Cells = append(sheet.Cells, excelmetadata.CellMetadata{Address: fmt.Sprintf("A%d", i), Formula: fmt.Sprintf("A%d+1", i-1)})
+	}
+	sheet.Cells = append(sheet.Cells, excelmetadata.CellMetadata{Address: "B1", Formula: "SUM(A:A)"})
+	g := excelmetadata.NewDependencyGraph(&excelmetadata.Metadata{Sheets: []excelmetadata.SheetMetadata{sheet}})
+
+	if len(g.Cycles) != 0 {
+		t.Errorf("cycles = %v", g.Cycles)
+	}
+	if got := g.Precedents(fmt.Sprintf("Sheet1!A%d", n), true); len(got) != n-1 || got[len(got)-1] != "Sheet1!A1" {
+		t.Errorf("found %d transitive precedents, want %d", len(got), n-1)
+	}
+	// Every chain cell and the total depend on A1
+	if got := g.Dependents("Sheet1!A1", true); len(got) != n {
+		t.Errorf("found %d transitive dependents, want %d", len(got), n)
+	}
+}
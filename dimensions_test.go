@@ -0,0 +1,108 @@
+package excelmetadata_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/prongbang/excelmetadata"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestSheetDimensions(t *testing.T) {
+	filename := newWorkbook(t, func(f *excelize.File) {
+		for _, sheet := range []string{"Offset", "Bloated", "Stale", "Empty"} {
+			if _, err := f.NewSheet(sheet); err != nil {
+				t.Fatal(err)
+			}
+		}
+		_ = f.DeleteSheet("Sheet1")
+
+		// Data starting at C5, with a gap in column D
+		_ = f.SetCellValue("Offset", "C5", "x")
+		_ = f.SetCellValue("Offset", "E7", "y")
+		_ = f.SetSheetDimension("Offset", "C5:E7")
+
+		// Formatting far below the data keeps Excel's used range large
+		style, _ := f.NewStyle(&excelize.Style{Fill: excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{"FFFF00"}}})
+		_ = f.SetCellValue("Bloated", "A1", "x")
+		_ = f.SetCellStyle("Bloated", "Z100", "Z100", style)
+		_ = f.SetSheetDimension("Bloated", "A1:Z100")
+
+		_ = f.SetCellValue("Stale", "A1", "x")
+		_ = f.SetCellValue("Stale", "C3", "y")
+		_ = f.SetSheetDimension("Stale", "A1:B2")
+	})
+	metadata := extract(t, filename)
+
+	tests := []struct {
+		sheet string
+		want  excelmetadata.SheetDimensions
+	}{
+		{"Offset", excelmetadata.SheetDimensions{
+			StartCell: "C5", EndCell: "E7", RowCount: 3, ColCount: 3,
+			DeclaredRange: "C5:E7", CellRange: "C5:E7",
+		}},
+		{"Bloated", excelmetadata.SheetDimensions{
+			StartCell: "A1", EndCell: "A1", RowCount: 1, ColCount: 1,
+			DeclaredRange: "A1:Z100", CellRange: "A1:Z100", Mismatch: excelmetadata.DimensionBloated,
+		}},
+		{"Stale", excelmetadata.SheetDimensions{
+			StartCell: "A1", EndCell: "C3", RowCount: 3, ColCount: 3,
+			DeclaredRange: "A1:B2", CellRange: "A1:C3", Mismatch: excelmetadata.DimensionStale,
+		}},
+		{"Empty", excelmetadata.SheetDimensions{
+			StartCell: "A1", EndCell: "A1", DeclaredRange: "A1",
+		}},
+	}
+	for i, tt := range tests {
+		sheet := metadata.Sheets[i]
+		if sheet.Name != tt.sheet {
+			t.Fatalf("sheet %d = %s, want %s", i, sheet.Name, tt.sheet)
+		}
+		if sheet.Dimensions != tt.want {
+			t.Errorf("%s dimensions = %+v, want %+v", tt.sheet, sheet.Dimensions, tt.want)
+		}
+	}
+}
+
+// Worksheet parts over excelize's UnzipXMLSizeLimit (16 MB) are kept in
+// temporary files instead of the package
+func TestLargeWorksheetPart(t *testing.T) {
+	filename := newWorkbook(t, func(f *excelize.File) {
+		style, _ := f.NewStyle(&excelize.Style{Fill: excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{"FFFF00"}}})
+		_ = f.SetCellValue("Sheet1", "A1", "x")
+		_ = f.SetCellStyle("Sheet1", "D4", "D4", style)
+		_ = f.SetCellHyperLink("Sheet1", "A1", "file:///C:/Temp/run.exe", "External")
+		if err := f.ProtectSheet("Sheet1", &excelize.SheetProtectionOptions{Password: "secret"}); err != nil {
+			t.Fatal(err)
+		}
+	})
+	rewritePart(t, filename, "xl/worksheets/sheet1.xml", "</worksheet>", strings.Repeat(" ", 17<<20)+"</worksheet>")
+
+	metadata := extractWithOptions(t, filename, func(o *excelmetadata.Options) { o.AuditSecurity = true })
+	sheet := metadata.Sheets[0]
+	if sheet.Dimensions.CellRange != "A1:D4" {
+		t.Errorf("cell range = %q, want A1:D4", sheet.Dimensions.CellRange)
+	}
+	if sheet.Protection == nil || !sheet.Protection.Protected {
+		t.Errorf("protection = %+v", sheet.Protection)
+	}
+	if want := []excelmetadata.SecurityCell{{Sheet: "Sheet1", Cell: "A1", Target: "file:///C:/Temp/run.exe"}}; !reflect.DeepEqual(metadata.Security.Hyperlinks, want) {
+		t.Errorf("hyperlinks = %+v, want %+v", metadata.Security.Hyperlinks, want)
+	}
+
+	// The audit alone reads the part before excelize loads it
+	extractor, err := excelmetadata.New(filename, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer extractor.Close()
+	report, err := extractor.AuditSecurity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Hyperlinks) != 1 {
+		t.Errorf("audit hyperlinks = %+v", report.Hyperlinks)
+	}
+}
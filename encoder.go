@@ -0,0 +1,204 @@
+package excelmetadata
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Encoder writes metadata in an output format
+type Encoder interface {
+	Encode(w io.Writer, metadata *Metadata, options *EncodeOptions) error
+}
+
+// EncoderFunc adapts a function to the Encoder interface
+type EncoderFunc func(w io.Writer, metadata *Metadata, options *EncodeOptions) error
+
+// Encode calls f(w, metadata, options)
+func (f EncoderFunc) Encode(w io.Writer, metadata *Metadata, options *EncodeOptions) error {
+	return f(w, metadata, options)
+}
+
+// EncodeOptions carries the output settings an encoder may honour
+type EncodeOptions struct {
+	Pretty     bool
+	GoPackage  string
+	GoVariable string
+}
+
+// EncoderInfo describes a registered encoder
+type EncoderInfo struct {
+	Name       string
+	Extensions []string
+}
+
+type registeredEncoder struct {
+	info    EncoderInfo
+	encoder Encoder
+}
+
+var (
+	encodersMu   sync.RWMutex
+	encoders     = map[string]registeredEncoder{}
+	encoderByExt = map[string]string{}
+)
+
+func init() {
+	RegisterEncoder(FormatJSON, []string{".json"}, EncoderFunc(encodeJSON))
+	RegisterEncoder(FormatYAML, []string{".yaml", ".yml"}, EncoderFunc(encodeYAML))
+	RegisterEncoder(FormatTOML, []string{".toml"}, EncoderFunc(encodeTOML))
+	RegisterEncoder(FormatGo, []string{".go"}, EncoderFunc(encodeGo))
+}
+
+// RegisterEncoder makes an output format available to ExtractToFile,
+// ExtractTo and the CLI under name and the given file extensions.
+// Registering an existing name replaces it with its extensions, and an
+// extension registered again moves to the new encoder.
+func RegisterEncoder(name string, extensions []string, enc Encoder) {
+	name = strings.ToLower(name)
+	if name == "" || enc == nil {
+		panic("excelmetadata: RegisterEncoder requires a name and an encoder")
+	}
+
+	encodersMu.Lock()
+	defer encodersMu.Unlock()
+
+	if old, ok := encoders[name]; ok {
+		for _, ext := range old.info.Extensions {
+			delete(encoderByExt, ext)
+		}
+	}
+
+	info := EncoderInfo{Name: name}
+	for _, ext := range extensions {
+		ext = strings.ToLower(ext)
+		if !strings.HasPrefix(ext, ".") {
+			ext = "." + ext
+		}
+		if owner, ok := encoderByExt[ext]; ok && owner == name {
+			continue
+		} else if ok {
+			removeEncoderExtension(owner, ext)
+		}
+		info.Extensions = append(info.Extensions, ext)
+		encoderByExt[ext] = name
+	}
+	encoders[name] = registeredEncoder{info: info, encoder: enc}
+}
+
+// removeEncoderExtension drops ext from the extensions listed for an
+// encoder. The list is copied, as Encoders hands it out.
+func removeEncoderExtension(name, ext string) {
+	reg := encoders[name]
+	var kept []string
+	for _, e := range reg.info.Extensions {
+		if e != ext {
+			kept = append(kept, e)
+		}
+	}
+	reg.info.Extensions = kept
+	encoders[name] = reg
+}
+
+// LookupEncoder returns the encoder registered under name. A registered
+// extension without the dot, such as "yml", is accepted as well.
+func LookupEncoder(name string) (Encoder, bool) {
+	encodersMu.RLock()
+	defer encodersMu.RUnlock()
+
+	name = strings.ToLower(name)
+	if reg, ok := encoders[name]; ok {
+		return reg.encoder, true
+	}
+	if alias, ok := encoderByExt["."+name]; ok {
+		return encoders[alias].encoder, true
+	}
+	return nil, false
+}
+
+// FormatForExtension returns the encoder name registered for a file extension
+func FormatForExtension(ext string) (string, bool) {
+	encodersMu.RLock()
+	defer encodersMu.RUnlock()
+
+	ext = strings.ToLower(ext)
+	if !strings.HasPrefix(ext, ".") {
+		ext = "." + ext
+	}
+	name, ok := encoderByExt[ext]
+	return name, ok
+}
+
+// Encoders lists the registered encoders sorted by name
+func Encoders() []EncoderInfo {
+	encodersMu.RLock()
+	defer encodersMu.RUnlock()
+
+	infos := make([]EncoderInfo, 0, len(encoders))
+	for _, reg := range encoders {
+		infos = append(infos, reg.info)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos
+}
+
+// Encode writes metadata to w with the encoder registered under format
+func Encode(w io.Writer, format string, metadata *Metadata, options *EncodeOptions) error {
+	enc, ok := LookupEncoder(format)
+	if !ok {
+		return fmt.Errorf("unsupported format %q", format)
+	}
+	if options == nil {
+		options = &EncodeOptions{}
+	}
+	return enc.Encode(w, metadata, options)
+}
+
+// Built-in encoders
+
+func encodeJSON(w io.Writer, metadata *Metadata, options *EncodeOptions) error {
+	var (
+		data []byte
+		err  error
+	)
+	if options.Pretty {
+		data, err = json.MarshalIndent(metadata, "", "  ")
+	} else {
+		data, err = json.Marshal(metadata)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to marshal to JSON: %w", err)
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+func encodeYAML(w io.Writer, metadata *Metadata, _ *EncodeOptions) error {
+	data, err := MarshalYAML(metadata)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+func encodeTOML(w io.Writer, metadata *Metadata, _ *EncodeOptions) error {
+	data, err := MarshalTOML(metadata)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+func encodeGo(w io.Writer, metadata *Metadata, options *EncodeOptions) error {
+	data, err := MarshalGo(metadata, options.GoPackage, options.GoVariable)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
@@ -0,0 +1,126 @@
+package excelmetadata_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/prongbang/excelmetadata"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestBuiltinEncoders(t *testing.T) {
+	want := map[string]string{
+		excelmetadata.FormatGo:   ".go",
+		excelmetadata.FormatJSON: ".json",
+		excelmetadata.FormatTOML: ".toml",
+		excelmetadata.FormatYAML: ".yaml",
+	}
+	for name, ext := range want {
+		if _, ok := excelmetadata.LookupEncoder(name); !ok {
+			t.Errorf("encoder %q is not registered", name)
+		}
+		if got, ok := excelmetadata.FormatForExtension(ext); !ok || got != name {
+			t.Errorf("FormatForExtension(%q) = %q, %v, want %q", ext, got, ok, name)
+		}
+	}
+	if got, _ := excelmetadata.FormatForExtension(".YML"); got != excelmetadata.FormatYAML {
+		t.Errorf("FormatForExtension(.YML) = %q, want yaml", got)
+	}
+	if _, ok := excelmetadata.LookupEncoder("yml"); !ok {
+		t.Error("extension alias yml is not accepted as a format")
+	}
+	if _, ok := excelmetadata.LookupEncoder("xml"); ok {
+		t.Error("xml should not be registered")
+	}
+}
+
+func TestRegisterEncoder(t *testing.T) {
+	excelmetadata.RegisterEncoder("sheets", []string{"sheets"}, excelmetadata.EncoderFunc(
+		func(w io.Writer, metadata *excelmetadata.Metadata, _ *excelmetadata.EncodeOptions) error {
+			for _, sheet := range metadata.Sheets {
+				if _, err := fmt.Fprintln(w, sheet.Name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}))
+
+	found := false
+	for _, info := range excelmetadata.Encoders() {
+		if info.Name == "sheets" {
+			found = len(info.Extensions) == 1 && info.Extensions[0] == ".sheets"
+		}
+	}
+	if !found {
+		t.Fatalf("Encoders() does not list sheets with extension .sheets: %+v", excelmetadata.Encoders())
+	}
+
+	filename := newWorkbook(t, func(*excelize.File) {})
+	extractor, err := excelmetadata.New(filename, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer extractor.Close()
+
+	var buf bytes.Buffer
+	if err := extractor.ExtractTo(&buf, "sheets", false); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "Sheet1\n" {
+		t.Errorf("ExtractTo() = %q, want Sheet1", buf.String())
+	}
+
+	output := filepath.Join(t.TempDir(), "out.sheets")
+	if err := extractor.ExtractToFile(output, false); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "Sheet1\n" {
+		t.Errorf("ExtractToFile() wrote %q, want Sheet1", data)
+	}
+
+	if err := extractor.ExtractToFile(filepath.Join(t.TempDir(), "out.xml"), false); err == nil {
+		t.Error("ExtractToFile() with unknown extension should fail")
+	}
+}
+
+func TestRegisterEncoderReplaces(t *testing.T) {
+	enc := excelmetadata.EncoderFunc(func(io.Writer, *excelmetadata.Metadata, *excelmetadata.EncodeOptions) error { return nil })
+	extensions := func(name string) []string {
+		for _, info := range excelmetadata.Encoders() {
+			if info.Name == name {
+				return info.Extensions
+			}
+		}
+		return nil
+	}
+
+	excelmetadata.RegisterEncoder("first", []string{".first", ".shared"}, enc)
+	excelmetadata.RegisterEncoder("second", []string{".shared"}, enc)
+	if got := extensions("first"); !reflect.DeepEqual(got, []string{".first"}) {
+		t.Errorf("first extensions = %v, want [.first]", got)
+	}
+	if name, _ := excelmetadata.FormatForExtension(".shared"); name != "second" {
+		t.Errorf(".shared maps to %q, want second", name)
+	}
+
+	// Replacing an encoder drops the extensions it no longer lists
+	excelmetadata.RegisterEncoder("first", []string{".renamed"}, enc)
+	if got := extensions("first"); !reflect.DeepEqual(got, []string{".renamed"}) {
+		t.Errorf("first extensions = %v, want [.renamed]", got)
+	}
+	if name, ok := excelmetadata.FormatForExtension(".first"); ok {
+		t.Errorf(".first still maps to %q", name)
+	}
+	if _, ok := excelmetadata.LookupEncoder("first"); !ok {
+		t.Error("first is no longer registered")
+	}
+}
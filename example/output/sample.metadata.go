@@ -0,0 +1,5148 @@
+// Code generated by excelmetadata. DO NOT EDIT.
+
+package main
+
+import (
+	"time"
+
+	"github.com/prongbang/excelmetadata"
+	"github.com/prongbang/excelrecreator"
+	"github.com/xuri/excelize/v2"
+)
+
+var metadata = &excelmetadata.Metadata{
+	Filename: "sample.xlsx",
+	Properties: excelmetadata.DocumentProperties{
+		Created:  "2025-06-01T14:33:19Z",
+		Modified: "2025-06-01T14:33:19Z",
+	},
+	Sheets: []excelmetadata.SheetMetadata{
+		{
+			Name:    "Sheet1",
+			Visible: true,
+			Dimensions: excelmetadata.SheetDimensions{
+				StartCell:     "A1",
+				EndCell:       "J101",
+				RowCount:      101,
+				ColCount:      10,
+				DeclaredRange: "A1:J101",
+				CellRange:     "A1:J101",
+			},
+			RowHeights: map[int]float64{},
+			ColWidths:  map[string]float64{},
+			Cells: []excelmetadata.CellMetadata{
+				{
+					Address: "A1",
+					Value:   "Column1",
+					StyleID: 1,
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B1",
+					Value:   "Column2",
+					StyleID: 1,
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C1",
+					Value:   "Column3",
+					StyleID: 1,
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D1",
+					Value:   "Column4",
+					StyleID: 1,
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E1",
+					Value:   "Column5",
+					StyleID: 1,
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F1",
+					Value:   "Column6",
+					StyleID: 1,
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G1",
+					Value:   "Column7",
+					StyleID: 1,
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H1",
+					Value:   "Column8",
+					StyleID: 1,
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I1",
+					Value:   "Column9",
+					StyleID: 1,
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J1",
+					Value:   "Column10",
+					StyleID: 1,
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A2",
+					Value:   "R1C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B2",
+					Value:   "R1C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C2",
+					Value:   "R1C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D2",
+					Value:   "R1C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E2",
+					Value:   "R1C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F2",
+					Value:   "R1C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G2",
+					Value:   "R1C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H2",
+					Value:   "R1C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I2",
+					Value:   "R1C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J2",
+					Value:   "R1C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A3",
+					Value:   "R2C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B3",
+					Value:   "R2C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C3",
+					Value:   "R2C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D3",
+					Value:   "R2C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E3",
+					Value:   "R2C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F3",
+					Value:   "R2C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G3",
+					Value:   "R2C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H3",
+					Value:   "R2C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I3",
+					Value:   "R2C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J3",
+					Value:   "R2C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A4",
+					Value:   "R3C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B4",
+					Value:   "R3C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C4",
+					Value:   "R3C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D4",
+					Value:   "R3C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E4",
+					Value:   "R3C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F4",
+					Value:   "R3C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G4",
+					Value:   "R3C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H4",
+					Value:   "R3C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I4",
+					Value:   "R3C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J4",
+					Value:   "R3C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A5",
+					Value:   "R4C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B5",
+					Value:   "R4C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C5",
+					Value:   "R4C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D5",
+					Value:   "R4C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E5",
+					Value:   "R4C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F5",
+					Value:   "R4C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G5",
+					Value:   "R4C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H5",
+					Value:   "R4C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I5",
+					Value:   "R4C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J5",
+					Value:   "R4C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A6",
+					Value:   "R5C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B6",
+					Value:   "R5C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C6",
+					Value:   "R5C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D6",
+					Value:   "R5C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E6",
+					Value:   "R5C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F6",
+					Value:   "R5C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G6",
+					Value:   "R5C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H6",
+					Value:   "R5C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I6",
+					Value:   "R5C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J6",
+					Value:   "R5C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A7",
+					Value:   "R6C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B7",
+					Value:   "R6C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C7",
+					Value:   "R6C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D7",
+					Value:   "R6C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E7",
+					Value:   "R6C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F7",
+					Value:   "R6C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G7",
+					Value:   "R6C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H7",
+					Value:   "R6C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I7",
+					Value:   "R6C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J7",
+					Value:   "R6C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A8",
+					Value:   "R7C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B8",
+					Value:   "R7C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C8",
+					Value:   "R7C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D8",
+					Value:   "R7C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E8",
+					Value:   "R7C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F8",
+					Value:   "R7C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G8",
+					Value:   "R7C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H8",
+					Value:   "R7C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I8",
+					Value:   "R7C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J8",
+					Value:   "R7C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A9",
+					Value:   "R8C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B9",
+					Value:   "R8C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C9",
+					Value:   "R8C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D9",
+					Value:   "R8C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E9",
+					Value:   "R8C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F9",
+					Value:   "R8C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G9",
+					Value:   "R8C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H9",
+					Value:   "R8C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I9",
+					Value:   "R8C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J9",
+					Value:   "R8C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A10",
+					Value:   "R9C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B10",
+					Value:   "R9C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C10",
+					Value:   "R9C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D10",
+					Value:   "R9C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E10",
+					Value:   "R9C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F10",
+					Value:   "R9C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G10",
+					Value:   "R9C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H10",
+					Value:   "R9C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I10",
+					Value:   "R9C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J10",
+					Value:   "R9C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A11",
+					Value:   "R10C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B11",
+					Value:   "R10C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C11",
+					Value:   "R10C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D11",
+					Value:   "R10C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E11",
+					Value:   "R10C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F11",
+					Value:   "R10C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G11",
+					Value:   "R10C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H11",
+					Value:   "R10C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I11",
+					Value:   "R10C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J11",
+					Value:   "R10C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A12",
+					Value:   "R11C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B12",
+					Value:   "R11C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C12",
+					Value:   "R11C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D12",
+					Value:   "R11C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E12",
+					Value:   "R11C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F12",
+					Value:   "R11C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G12",
+					Value:   "R11C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H12",
+					Value:   "R11C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I12",
+					Value:   "R11C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J12",
+					Value:   "R11C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A13",
+					Value:   "R12C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B13",
+					Value:   "R12C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C13",
+					Value:   "R12C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D13",
+					Value:   "R12C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E13",
+					Value:   "R12C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F13",
+					Value:   "R12C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G13",
+					Value:   "R12C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H13",
+					Value:   "R12C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I13",
+					Value:   "R12C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J13",
+					Value:   "R12C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A14",
+					Value:   "R13C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B14",
+					Value:   "R13C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C14",
+					Value:   "R13C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D14",
+					Value:   "R13C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E14",
+					Value:   "R13C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F14",
+					Value:   "R13C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G14",
+					Value:   "R13C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H14",
+					Value:   "R13C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I14",
+					Value:   "R13C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J14",
+					Value:   "R13C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A15",
+					Value:   "R14C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B15",
+					Value:   "R14C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C15",
+					Value:   "R14C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D15",
+					Value:   "R14C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E15",
+					Value:   "R14C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F15",
+					Value:   "R14C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G15",
+					Value:   "R14C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H15",
+					Value:   "R14C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I15",
+					Value:   "R14C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J15",
+					Value:   "R14C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A16",
+					Value:   "R15C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B16",
+					Value:   "R15C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C16",
+					Value:   "R15C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D16",
+					Value:   "R15C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E16",
+					Value:   "R15C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F16",
+					Value:   "R15C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G16",
+					Value:   "R15C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H16",
+					Value:   "R15C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I16",
+					Value:   "R15C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J16",
+					Value:   "R15C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A17",
+					Value:   "R16C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B17",
+					Value:   "R16C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C17",
+					Value:   "R16C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D17",
+					Value:   "R16C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E17",
+					Value:   "R16C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F17",
+					Value:   "R16C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G17",
+					Value:   "R16C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H17",
+					Value:   "R16C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I17",
+					Value:   "R16C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J17",
+					Value:   "R16C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A18",
+					Value:   "R17C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B18",
+					Value:   "R17C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C18",
+					Value:   "R17C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D18",
+					Value:   "R17C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E18",
+					Value:   "R17C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F18",
+					Value:   "R17C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G18",
+					Value:   "R17C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H18",
+					Value:   "R17C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I18",
+					Value:   "R17C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J18",
+					Value:   "R17C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A19",
+					Value:   "R18C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B19",
+					Value:   "R18C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C19",
+					Value:   "R18C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D19",
+					Value:   "R18C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E19",
+					Value:   "R18C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F19",
+					Value:   "R18C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G19",
+					Value:   "R18C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H19",
+					Value:   "R18C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I19",
+					Value:   "R18C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J19",
+					Value:   "R18C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A20",
+					Value:   "R19C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B20",
+					Value:   "R19C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C20",
+					Value:   "R19C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D20",
+					Value:   "R19C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E20",
+					Value:   "R19C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F20",
+					Value:   "R19C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G20",
+					Value:   "R19C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H20",
+					Value:   "R19C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I20",
+					Value:   "R19C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J20",
+					Value:   "R19C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A21",
+					Value:   "R20C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B21",
+					Value:   "R20C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C21",
+					Value:   "R20C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D21",
+					Value:   "R20C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E21",
+					Value:   "R20C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F21",
+					Value:   "R20C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G21",
+					Value:   "R20C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H21",
+					Value:   "R20C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I21",
+					Value:   "R20C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J21",
+					Value:   "R20C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A22",
+					Value:   "R21C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B22",
+					Value:   "R21C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C22",
+					Value:   "R21C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D22",
+					Value:   "R21C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E22",
+					Value:   "R21C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F22",
+					Value:   "R21C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G22",
+					Value:   "R21C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H22",
+					Value:   "R21C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I22",
+					Value:   "R21C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J22",
+					Value:   "R21C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A23",
+					Value:   "R22C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B23",
+					Value:   "R22C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C23",
+					Value:   "R22C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D23",
+					Value:   "R22C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E23",
+					Value:   "R22C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F23",
+					Value:   "R22C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G23",
+					Value:   "R22C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H23",
+					Value:   "R22C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I23",
+					Value:   "R22C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J23",
+					Value:   "R22C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A24",
+					Value:   "R23C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B24",
+					Value:   "R23C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C24",
+					Value:   "R23C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D24",
+					Value:   "R23C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E24",
+					Value:   "R23C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F24",
+					Value:   "R23C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G24",
+					Value:   "R23C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H24",
+					Value:   "R23C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I24",
+					Value:   "R23C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J24",
+					Value:   "R23C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A25",
+					Value:   "R24C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B25",
+					Value:   "R24C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C25",
+					Value:   "R24C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D25",
+					Value:   "R24C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E25",
+					Value:   "R24C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F25",
+					Value:   "R24C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G25",
+					Value:   "R24C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H25",
+					Value:   "R24C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I25",
+					Value:   "R24C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J25",
+					Value:   "R24C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A26",
+					Value:   "R25C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B26",
+					Value:   "R25C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C26",
+					Value:   "R25C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D26",
+					Value:   "R25C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E26",
+					Value:   "R25C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F26",
+					Value:   "R25C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G26",
+					Value:   "R25C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H26",
+					Value:   "R25C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I26",
+					Value:   "R25C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J26",
+					Value:   "R25C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A27",
+					Value:   "R26C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B27",
+					Value:   "R26C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C27",
+					Value:   "R26C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D27",
+					Value:   "R26C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E27",
+					Value:   "R26C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F27",
+					Value:   "R26C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G27",
+					Value:   "R26C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H27",
+					Value:   "R26C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I27",
+					Value:   "R26C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J27",
+					Value:   "R26C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A28",
+					Value:   "R27C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B28",
+					Value:   "R27C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C28",
+					Value:   "R27C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D28",
+					Value:   "R27C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E28",
+					Value:   "R27C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F28",
+					Value:   "R27C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G28",
+					Value:   "R27C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H28",
+					Value:   "R27C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I28",
+					Value:   "R27C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J28",
+					Value:   "R27C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A29",
+					Value:   "R28C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B29",
+					Value:   "R28C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C29",
+					Value:   "R28C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D29",
+					Value:   "R28C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E29",
+					Value:   "R28C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F29",
+					Value:   "R28C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G29",
+					Value:   "R28C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H29",
+					Value:   "R28C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I29",
+					Value:   "R28C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J29",
+					Value:   "R28C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A30",
+					Value:   "R29C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B30",
+					Value:   "R29C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C30",
+					Value:   "R29C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D30",
+					Value:   "R29C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E30",
+					Value:   "R29C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F30",
+					Value:   "R29C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G30",
+					Value:   "R29C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H30",
+					Value:   "R29C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I30",
+					Value:   "R29C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J30",
+					Value:   "R29C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A31",
+					Value:   "R30C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B31",
+					Value:   "R30C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C31",
+					Value:   "R30C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D31",
+					Value:   "R30C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E31",
+					Value:   "R30C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F31",
+					Value:   "R30C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G31",
+					Value:   "R30C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H31",
+					Value:   "R30C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I31",
+					Value:   "R30C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J31",
+					Value:   "R30C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A32",
+					Value:   "R31C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B32",
+					Value:   "R31C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C32",
+					Value:   "R31C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D32",
+					Value:   "R31C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E32",
+					Value:   "R31C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F32",
+					Value:   "R31C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G32",
+					Value:   "R31C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H32",
+					Value:   "R31C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I32",
+					Value:   "R31C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J32",
+					Value:   "R31C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A33",
+					Value:   "R32C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B33",
+					Value:   "R32C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C33",
+					Value:   "R32C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D33",
+					Value:   "R32C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E33",
+					Value:   "R32C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F33",
+					Value:   "R32C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G33",
+					Value:   "R32C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H33",
+					Value:   "R32C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I33",
+					Value:   "R32C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J33",
+					Value:   "R32C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A34",
+					Value:   "R33C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B34",
+					Value:   "R33C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C34",
+					Value:   "R33C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D34",
+					Value:   "R33C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E34",
+					Value:   "R33C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F34",
+					Value:   "R33C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G34",
+					Value:   "R33C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H34",
+					Value:   "R33C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I34",
+					Value:   "R33C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J34",
+					Value:   "R33C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A35",
+					Value:   "R34C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B35",
+					Value:   "R34C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C35",
+					Value:   "R34C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D35",
+					Value:   "R34C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E35",
+					Value:   "R34C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F35",
+					Value:   "R34C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G35",
+					Value:   "R34C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H35",
+					Value:   "R34C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I35",
+					Value:   "R34C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J35",
+					Value:   "R34C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A36",
+					Value:   "R35C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B36",
+					Value:   "R35C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C36",
+					Value:   "R35C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D36",
+					Value:   "R35C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E36",
+					Value:   "R35C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F36",
+					Value:   "R35C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G36",
+					Value:   "R35C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H36",
+					Value:   "R35C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I36",
+					Value:   "R35C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J36",
+					Value:   "R35C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A37",
+					Value:   "R36C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B37",
+					Value:   "R36C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C37",
+					Value:   "R36C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D37",
+					Value:   "R36C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E37",
+					Value:   "R36C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F37",
+					Value:   "R36C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G37",
+					Value:   "R36C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H37",
+					Value:   "R36C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I37",
+					Value:   "R36C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J37",
+					Value:   "R36C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A38",
+					Value:   "R37C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B38",
+					Value:   "R37C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C38",
+					Value:   "R37C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D38",
+					Value:   "R37C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E38",
+					Value:   "R37C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F38",
+					Value:   "R37C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G38",
+					Value:   "R37C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H38",
+					Value:   "R37C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I38",
+					Value:   "R37C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J38",
+					Value:   "R37C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A39",
+					Value:   "R38C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B39",
+					Value:   "R38C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C39",
+					Value:   "R38C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D39",
+					Value:   "R38C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E39",
+					Value:   "R38C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F39",
+					Value:   "R38C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G39",
+					Value:   "R38C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H39",
+					Value:   "R38C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I39",
+					Value:   "R38C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J39",
+					Value:   "R38C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A40",
+					Value:   "R39C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B40",
+					Value:   "R39C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C40",
+					Value:   "R39C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D40",
+					Value:   "R39C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E40",
+					Value:   "R39C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F40",
+					Value:   "R39C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G40",
+					Value:   "R39C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H40",
+					Value:   "R39C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I40",
+					Value:   "R39C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J40",
+					Value:   "R39C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A41",
+					Value:   "R40C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B41",
+					Value:   "R40C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C41",
+					Value:   "R40C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D41",
+					Value:   "R40C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E41",
+					Value:   "R40C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F41",
+					Value:   "R40C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G41",
+					Value:   "R40C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H41",
+					Value:   "R40C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I41",
+					Value:   "R40C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J41",
+					Value:   "R40C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A42",
+					Value:   "R41C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B42",
+					Value:   "R41C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C42",
+					Value:   "R41C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D42",
+					Value:   "R41C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E42",
+					Value:   "R41C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F42",
+					Value:   "R41C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G42",
+					Value:   "R41C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H42",
+					Value:   "R41C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I42",
+					Value:   "R41C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J42",
+					Value:   "R41C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A43",
+					Value:   "R42C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B43",
+					Value:   "R42C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C43",
+					Value:   "R42C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D43",
+					Value:   "R42C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E43",
+					Value:   "R42C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F43",
+					Value:   "R42C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G43",
+					Value:   "R42C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H43",
+					Value:   "R42C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I43",
+					Value:   "R42C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J43",
+					Value:   "R42C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A44",
+					Value:   "R43C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B44",
+					Value:   "R43C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C44",
+					Value:   "R43C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D44",
+					Value:   "R43C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E44",
+					Value:   "R43C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F44",
+					Value:   "R43C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G44",
+					Value:   "R43C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H44",
+					Value:   "R43C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I44",
+					Value:   "R43C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J44",
+					Value:   "R43C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A45",
+					Value:   "R44C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B45",
+					Value:   "R44C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C45",
+					Value:   "R44C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D45",
+					Value:   "R44C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E45",
+					Value:   "R44C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F45",
+					Value:   "R44C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G45",
+					Value:   "R44C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H45",
+					Value:   "R44C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I45",
+					Value:   "R44C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J45",
+					Value:   "R44C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A46",
+					Value:   "R45C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B46",
+					Value:   "R45C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C46",
+					Value:   "R45C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D46",
+					Value:   "R45C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E46",
+					Value:   "R45C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F46",
+					Value:   "R45C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G46",
+					Value:   "R45C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H46",
+					Value:   "R45C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I46",
+					Value:   "R45C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J46",
+					Value:   "R45C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A47",
+					Value:   "R46C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B47",
+					Value:   "R46C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C47",
+					Value:   "R46C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D47",
+					Value:   "R46C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E47",
+					Value:   "R46C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F47",
+					Value:   "R46C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G47",
+					Value:   "R46C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H47",
+					Value:   "R46C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I47",
+					Value:   "R46C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J47",
+					Value:   "R46C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A48",
+					Value:   "R47C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B48",
+					Value:   "R47C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C48",
+					Value:   "R47C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D48",
+					Value:   "R47C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E48",
+					Value:   "R47C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F48",
+					Value:   "R47C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G48",
+					Value:   "R47C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H48",
+					Value:   "R47C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I48",
+					Value:   "R47C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J48",
+					Value:   "R47C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A49",
+					Value:   "R48C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B49",
+					Value:   "R48C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C49",
+					Value:   "R48C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D49",
+					Value:   "R48C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E49",
+					Value:   "R48C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F49",
+					Value:   "R48C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G49",
+					Value:   "R48C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H49",
+					Value:   "R48C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I49",
+					Value:   "R48C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J49",
+					Value:   "R48C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A50",
+					Value:   "R49C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B50",
+					Value:   "R49C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C50",
+					Value:   "R49C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D50",
+					Value:   "R49C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E50",
+					Value:   "R49C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F50",
+					Value:   "R49C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G50",
+					Value:   "R49C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H50",
+					Value:   "R49C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I50",
+					Value:   "R49C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J50",
+					Value:   "R49C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A51",
+					Value:   "R50C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B51",
+					Value:   "R50C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C51",
+					Value:   "R50C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D51",
+					Value:   "R50C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E51",
+					Value:   "R50C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F51",
+					Value:   "R50C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G51",
+					Value:   "R50C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H51",
+					Value:   "R50C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I51",
+					Value:   "R50C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J51",
+					Value:   "R50C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A52",
+					Value:   "R51C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B52",
+					Value:   "R51C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C52",
+					Value:   "R51C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D52",
+					Value:   "R51C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E52",
+					Value:   "R51C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F52",
+					Value:   "R51C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G52",
+					Value:   "R51C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H52",
+					Value:   "R51C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I52",
+					Value:   "R51C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J52",
+					Value:   "R51C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A53",
+					Value:   "R52C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B53",
+					Value:   "R52C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C53",
+					Value:   "R52C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D53",
+					Value:   "R52C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E53",
+					Value:   "R52C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F53",
+					Value:   "R52C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G53",
+					Value:   "R52C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H53",
+					Value:   "R52C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I53",
+					Value:   "R52C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J53",
+					Value:   "R52C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A54",
+					Value:   "R53C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B54",
+					Value:   "R53C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C54",
+					Value:   "R53C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D54",
+					Value:   "R53C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E54",
+					Value:   "R53C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F54",
+					Value:   "R53C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G54",
+					Value:   "R53C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H54",
+					Value:   "R53C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I54",
+					Value:   "R53C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J54",
+					Value:   "R53C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A55",
+					Value:   "R54C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B55",
+					Value:   "R54C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C55",
+					Value:   "R54C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D55",
+					Value:   "R54C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E55",
+					Value:   "R54C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F55",
+					Value:   "R54C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G55",
+					Value:   "R54C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H55",
+					Value:   "R54C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I55",
+					Value:   "R54C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J55",
+					Value:   "R54C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A56",
+					Value:   "R55C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B56",
+					Value:   "R55C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C56",
+					Value:   "R55C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D56",
+					Value:   "R55C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E56",
+					Value:   "R55C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F56",
+					Value:   "R55C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G56",
+					Value:   "R55C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H56",
+					Value:   "R55C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I56",
+					Value:   "R55C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J56",
+					Value:   "R55C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A57",
+					Value:   "R56C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B57",
+					Value:   "R56C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C57",
+					Value:   "R56C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D57",
+					Value:   "R56C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E57",
+					Value:   "R56C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F57",
+					Value:   "R56C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G57",
+					Value:   "R56C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H57",
+					Value:   "R56C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I57",
+					Value:   "R56C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J57",
+					Value:   "R56C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A58",
+					Value:   "R57C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B58",
+					Value:   "R57C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C58",
+					Value:   "R57C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D58",
+					Value:   "R57C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E58",
+					Value:   "R57C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F58",
+					Value:   "R57C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G58",
+					Value:   "R57C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H58",
+					Value:   "R57C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I58",
+					Value:   "R57C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J58",
+					Value:   "R57C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A59",
+					Value:   "R58C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B59",
+					Value:   "R58C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C59",
+					Value:   "R58C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D59",
+					Value:   "R58C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E59",
+					Value:   "R58C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F59",
+					Value:   "R58C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G59",
+					Value:   "R58C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H59",
+					Value:   "R58C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I59",
+					Value:   "R58C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J59",
+					Value:   "R58C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A60",
+					Value:   "R59C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B60",
+					Value:   "R59C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C60",
+					Value:   "R59C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D60",
+					Value:   "R59C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E60",
+					Value:   "R59C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F60",
+					Value:   "R59C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G60",
+					Value:   "R59C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H60",
+					Value:   "R59C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I60",
+					Value:   "R59C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J60",
+					Value:   "R59C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A61",
+					Value:   "R60C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B61",
+					Value:   "R60C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C61",
+					Value:   "R60C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D61",
+					Value:   "R60C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E61",
+					Value:   "R60C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F61",
+					Value:   "R60C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G61",
+					Value:   "R60C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H61",
+					Value:   "R60C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I61",
+					Value:   "R60C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J61",
+					Value:   "R60C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A62",
+					Value:   "R61C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B62",
+					Value:   "R61C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C62",
+					Value:   "R61C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D62",
+					Value:   "R61C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E62",
+					Value:   "R61C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F62",
+					Value:   "R61C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G62",
+					Value:   "R61C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H62",
+					Value:   "R61C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I62",
+					Value:   "R61C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J62",
+					Value:   "R61C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A63",
+					Value:   "R62C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B63",
+					Value:   "R62C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C63",
+					Value:   "R62C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D63",
+					Value:   "R62C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E63",
+					Value:   "R62C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F63",
+					Value:   "R62C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G63",
+					Value:   "R62C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H63",
+					Value:   "R62C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I63",
+					Value:   "R62C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J63",
+					Value:   "R62C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A64",
+					Value:   "R63C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B64",
+					Value:   "R63C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C64",
+					Value:   "R63C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D64",
+					Value:   "R63C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E64",
+					Value:   "R63C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F64",
+					Value:   "R63C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G64",
+					Value:   "R63C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H64",
+					Value:   "R63C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I64",
+					Value:   "R63C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J64",
+					Value:   "R63C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A65",
+					Value:   "R64C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B65",
+					Value:   "R64C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C65",
+					Value:   "R64C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D65",
+					Value:   "R64C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E65",
+					Value:   "R64C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F65",
+					Value:   "R64C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G65",
+					Value:   "R64C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H65",
+					Value:   "R64C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I65",
+					Value:   "R64C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J65",
+					Value:   "R64C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A66",
+					Value:   "R65C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B66",
+					Value:   "R65C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C66",
+					Value:   "R65C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D66",
+					Value:   "R65C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E66",
+					Value:   "R65C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F66",
+					Value:   "R65C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G66",
+					Value:   "R65C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H66",
+					Value:   "R65C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I66",
+					Value:   "R65C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J66",
+					Value:   "R65C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A67",
+					Value:   "R66C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B67",
+					Value:   "R66C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C67",
+					Value:   "R66C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D67",
+					Value:   "R66C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E67",
+					Value:   "R66C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F67",
+					Value:   "R66C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G67",
+					Value:   "R66C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H67",
+					Value:   "R66C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I67",
+					Value:   "R66C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J67",
+					Value:   "R66C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A68",
+					Value:   "R67C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B68",
+					Value:   "R67C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C68",
+					Value:   "R67C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D68",
+					Value:   "R67C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E68",
+					Value:   "R67C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F68",
+					Value:   "R67C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G68",
+					Value:   "R67C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H68",
+					Value:   "R67C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I68",
+					Value:   "R67C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J68",
+					Value:   "R67C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A69",
+					Value:   "R68C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B69",
+					Value:   "R68C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C69",
+					Value:   "R68C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D69",
+					Value:   "R68C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E69",
+					Value:   "R68C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F69",
+					Value:   "R68C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G69",
+					Value:   "R68C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H69",
+					Value:   "R68C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I69",
+					Value:   "R68C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J69",
+					Value:   "R68C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A70",
+					Value:   "R69C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B70",
+					Value:   "R69C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C70",
+					Value:   "R69C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D70",
+					Value:   "R69C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E70",
+					Value:   "R69C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F70",
+					Value:   "R69C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G70",
+					Value:   "R69C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H70",
+					Value:   "R69C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I70",
+					Value:   "R69C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J70",
+					Value:   "R69C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A71",
+					Value:   "R70C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B71",
+					Value:   "R70C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C71",
+					Value:   "R70C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D71",
+					Value:   "R70C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E71",
+					Value:   "R70C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F71",
+					Value:   "R70C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G71",
+					Value:   "R70C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H71",
+					Value:   "R70C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I71",
+					Value:   "R70C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J71",
+					Value:   "R70C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A72",
+					Value:   "R71C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B72",
+					Value:   "R71C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C72",
+					Value:   "R71C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D72",
+					Value:   "R71C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E72",
+					Value:   "R71C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F72",
+					Value:   "R71C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G72",
+					Value:   "R71C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H72",
+					Value:   "R71C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I72",
+					Value:   "R71C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J72",
+					Value:   "R71C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A73",
+					Value:   "R72C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B73",
+					Value:   "R72C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C73",
+					Value:   "R72C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D73",
+					Value:   "R72C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E73",
+					Value:   "R72C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F73",
+					Value:   "R72C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G73",
+					Value:   "R72C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H73",
+					Value:   "R72C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I73",
+					Value:   "R72C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J73",
+					Value:   "R72C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A74",
+					Value:   "R73C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B74",
+					Value:   "R73C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C74",
+					Value:   "R73C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D74",
+					Value:   "R73C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E74",
+					Value:   "R73C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F74",
+					Value:   "R73C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G74",
+					Value:   "R73C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H74",
+					Value:   "R73C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I74",
+					Value:   "R73C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J74",
+					Value:   "R73C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A75",
+					Value:   "R74C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B75",
+					Value:   "R74C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C75",
+					Value:   "R74C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D75",
+					Value:   "R74C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E75",
+					Value:   "R74C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F75",
+					Value:   "R74C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G75",
+					Value:   "R74C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H75",
+					Value:   "R74C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I75",
+					Value:   "R74C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J75",
+					Value:   "R74C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A76",
+					Value:   "R75C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B76",
+					Value:   "R75C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C76",
+					Value:   "R75C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D76",
+					Value:   "R75C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E76",
+					Value:   "R75C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F76",
+					Value:   "R75C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G76",
+					Value:   "R75C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H76",
+					Value:   "R75C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I76",
+					Value:   "R75C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J76",
+					Value:   "R75C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A77",
+					Value:   "R76C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B77",
+					Value:   "R76C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C77",
+					Value:   "R76C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D77",
+					Value:   "R76C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E77",
+					Value:   "R76C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F77",
+					Value:   "R76C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G77",
+					Value:   "R76C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H77",
+					Value:   "R76C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I77",
+					Value:   "R76C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J77",
+					Value:   "R76C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A78",
+					Value:   "R77C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B78",
+					Value:   "R77C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C78",
+					Value:   "R77C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D78",
+					Value:   "R77C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E78",
+					Value:   "R77C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F78",
+					Value:   "R77C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G78",
+					Value:   "R77C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H78",
+					Value:   "R77C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I78",
+					Value:   "R77C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J78",
+					Value:   "R77C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A79",
+					Value:   "R78C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B79",
+					Value:   "R78C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C79",
+					Value:   "R78C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D79",
+					Value:   "R78C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E79",
+					Value:   "R78C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F79",
+					Value:   "R78C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G79",
+					Value:   "R78C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H79",
+					Value:   "R78C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I79",
+					Value:   "R78C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J79",
+					Value:   "R78C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A80",
+					Value:   "R79C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B80",
+					Value:   "R79C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C80",
+					Value:   "R79C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D80",
+					Value:   "R79C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E80",
+					Value:   "R79C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F80",
+					Value:   "R79C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G80",
+					Value:   "R79C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H80",
+					Value:   "R79C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I80",
+					Value:   "R79C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J80",
+					Value:   "R79C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A81",
+					Value:   "R80C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B81",
+					Value:   "R80C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C81",
+					Value:   "R80C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D81",
+					Value:   "R80C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E81",
+					Value:   "R80C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F81",
+					Value:   "R80C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G81",
+					Value:   "R80C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H81",
+					Value:   "R80C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I81",
+					Value:   "R80C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J81",
+					Value:   "R80C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A82",
+					Value:   "R81C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B82",
+					Value:   "R81C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C82",
+					Value:   "R81C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D82",
+					Value:   "R81C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E82",
+					Value:   "R81C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F82",
+					Value:   "R81C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G82",
+					Value:   "R81C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H82",
+					Value:   "R81C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I82",
+					Value:   "R81C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J82",
+					Value:   "R81C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A83",
+					Value:   "R82C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B83",
+					Value:   "R82C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C83",
+					Value:   "R82C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D83",
+					Value:   "R82C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E83",
+					Value:   "R82C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F83",
+					Value:   "R82C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G83",
+					Value:   "R82C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H83",
+					Value:   "R82C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I83",
+					Value:   "R82C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J83",
+					Value:   "R82C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A84",
+					Value:   "R83C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B84",
+					Value:   "R83C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C84",
+					Value:   "R83C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D84",
+					Value:   "R83C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E84",
+					Value:   "R83C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F84",
+					Value:   "R83C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G84",
+					Value:   "R83C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H84",
+					Value:   "R83C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I84",
+					Value:   "R83C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J84",
+					Value:   "R83C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A85",
+					Value:   "R84C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B85",
+					Value:   "R84C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C85",
+					Value:   "R84C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D85",
+					Value:   "R84C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E85",
+					Value:   "R84C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F85",
+					Value:   "R84C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G85",
+					Value:   "R84C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H85",
+					Value:   "R84C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I85",
+					Value:   "R84C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J85",
+					Value:   "R84C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A86",
+					Value:   "R85C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B86",
+					Value:   "R85C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C86",
+					Value:   "R85C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D86",
+					Value:   "R85C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E86",
+					Value:   "R85C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F86",
+					Value:   "R85C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G86",
+					Value:   "R85C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H86",
+					Value:   "R85C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I86",
+					Value:   "R85C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J86",
+					Value:   "R85C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A87",
+					Value:   "R86C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B87",
+					Value:   "R86C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C87",
+					Value:   "R86C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D87",
+					Value:   "R86C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E87",
+					Value:   "R86C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F87",
+					Value:   "R86C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G87",
+					Value:   "R86C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H87",
+					Value:   "R86C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I87",
+					Value:   "R86C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J87",
+					Value:   "R86C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A88",
+					Value:   "R87C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B88",
+					Value:   "R87C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C88",
+					Value:   "R87C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D88",
+					Value:   "R87C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E88",
+					Value:   "R87C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F88",
+					Value:   "R87C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G88",
+					Value:   "R87C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H88",
+					Value:   "R87C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I88",
+					Value:   "R87C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J88",
+					Value:   "R87C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A89",
+					Value:   "R88C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B89",
+					Value:   "R88C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C89",
+					Value:   "R88C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D89",
+					Value:   "R88C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E89",
+					Value:   "R88C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F89",
+					Value:   "R88C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G89",
+					Value:   "R88C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H89",
+					Value:   "R88C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I89",
+					Value:   "R88C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J89",
+					Value:   "R88C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A90",
+					Value:   "R89C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B90",
+					Value:   "R89C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C90",
+					Value:   "R89C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D90",
+					Value:   "R89C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E90",
+					Value:   "R89C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F90",
+					Value:   "R89C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G90",
+					Value:   "R89C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H90",
+					Value:   "R89C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I90",
+					Value:   "R89C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J90",
+					Value:   "R89C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A91",
+					Value:   "R90C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B91",
+					Value:   "R90C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C91",
+					Value:   "R90C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D91",
+					Value:   "R90C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E91",
+					Value:   "R90C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F91",
+					Value:   "R90C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G91",
+					Value:   "R90C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H91",
+					Value:   "R90C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I91",
+					Value:   "R90C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J91",
+					Value:   "R90C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A92",
+					Value:   "R91C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B92",
+					Value:   "R91C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C92",
+					Value:   "R91C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D92",
+					Value:   "R91C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E92",
+					Value:   "R91C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F92",
+					Value:   "R91C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G92",
+					Value:   "R91C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H92",
+					Value:   "R91C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I92",
+					Value:   "R91C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J92",
+					Value:   "R91C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A93",
+					Value:   "R92C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B93",
+					Value:   "R92C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C93",
+					Value:   "R92C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D93",
+					Value:   "R92C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E93",
+					Value:   "R92C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F93",
+					Value:   "R92C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G93",
+					Value:   "R92C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H93",
+					Value:   "R92C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I93",
+					Value:   "R92C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J93",
+					Value:   "R92C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A94",
+					Value:   "R93C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B94",
+					Value:   "R93C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C94",
+					Value:   "R93C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D94",
+					Value:   "R93C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E94",
+					Value:   "R93C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F94",
+					Value:   "R93C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G94",
+					Value:   "R93C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H94",
+					Value:   "R93C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I94",
+					Value:   "R93C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J94",
+					Value:   "R93C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A95",
+					Value:   "R94C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B95",
+					Value:   "R94C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C95",
+					Value:   "R94C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D95",
+					Value:   "R94C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E95",
+					Value:   "R94C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F95",
+					Value:   "R94C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G95",
+					Value:   "R94C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H95",
+					Value:   "R94C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I95",
+					Value:   "R94C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J95",
+					Value:   "R94C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A96",
+					Value:   "R95C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B96",
+					Value:   "R95C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C96",
+					Value:   "R95C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D96",
+					Value:   "R95C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E96",
+					Value:   "R95C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F96",
+					Value:   "R95C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G96",
+					Value:   "R95C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H96",
+					Value:   "R95C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I96",
+					Value:   "R95C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J96",
+					Value:   "R95C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A97",
+					Value:   "R96C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B97",
+					Value:   "R96C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C97",
+					Value:   "R96C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D97",
+					Value:   "R96C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E97",
+					Value:   "R96C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F97",
+					Value:   "R96C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G97",
+					Value:   "R96C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H97",
+					Value:   "R96C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I97",
+					Value:   "R96C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J97",
+					Value:   "R96C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A98",
+					Value:   "R97C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B98",
+					Value:   "R97C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C98",
+					Value:   "R97C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D98",
+					Value:   "R97C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E98",
+					Value:   "R97C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F98",
+					Value:   "R97C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G98",
+					Value:   "R97C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H98",
+					Value:   "R97C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I98",
+					Value:   "R97C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J98",
+					Value:   "R97C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A99",
+					Value:   "R98C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B99",
+					Value:   "R98C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C99",
+					Value:   "R98C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D99",
+					Value:   "R98C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E99",
+					Value:   "R98C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F99",
+					Value:   "R98C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G99",
+					Value:   "R98C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H99",
+					Value:   "R98C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I99",
+					Value:   "R98C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J99",
+					Value:   "R98C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A100",
+					Value:   "R99C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B100",
+					Value:   "R99C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C100",
+					Value:   "R99C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D100",
+					Value:   "R99C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E100",
+					Value:   "R99C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F100",
+					Value:   "R99C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G100",
+					Value:   "R99C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H100",
+					Value:   "R99C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I100",
+					Value:   "R99C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J100",
+					Value:   "R99C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "A101",
+					Value:   "R100C1",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "B101",
+					Value:   "R100C2",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "C101",
+					Value:   "R100C3",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "D101",
+					Value:   "R100C4",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "E101",
+					Value:   "R100C5",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "F101",
+					Value:   "R100C6",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "G101",
+					Value:   "R100C7",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "H101",
+					Value:   "R100C8",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "I101",
+					Value:   "R100C9",
+					Type:    excelize.CellTypeSharedString,
+				},
+				{
+					Address: "J101",
+					Value:   "R100C10",
+					Type:    excelize.CellTypeSharedString,
+				},
+			},
+			TotalNonEmptyCells: 1010,
+		},
+	},
+	Styles: map[int]excelmetadata.StyleDetails{
+		1: {
+			Font: &excelmetadata.FontStyle{
+				Bold:   true,
+				Family: "Calibri",
+				Size:   11,
+			},
+			Border: []excelmetadata.BorderStyle{
+				{
+					Type:  "left",
+					Color: "000000",
+					Style: 1,
+				},
+				{
+					Type:  "right",
+					Color: "000000",
+					Style: 1,
+				},
+				{
+					Type:  "top",
+					Color: "000000",
+					Style: 1,
+				},
+				{
+					Type:  "bottom",
+					Color: "000000",
+					Style: 1,
+				},
+			},
+			Alignment: &excelmetadata.AlignmentStyle{
+				Horizontal: "center",
+				Vertical:   "top",
+			},
+		},
+	},
+	ExtractedAt: time.Date(2026, time.October, 18, 13, 12, 12, 328152077, time.UTC),
+}
+
+func main() {
+	f := excelize.NewFile()
+
+	reCreator := &excelrecreator.Recreator{
+		File:     f,
+		Metadata: metadata,
+		Options:  excelrecreator.DefaultOptions(),
+		StyleMap: make(map[int]int),
+	}
+	_ = reCreator.Recreate()
+
+	_ = f.SaveAs("sample.clone.xlsx")
+}
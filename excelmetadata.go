@@ -1,23 +1,40 @@
 package excelmetadata
 
 import (
+	"archive/zip"
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
-	"github.com/pkg/errors"
 	"github.com/xuri/excelize/v2"
 )
 
 // Extractor is the main interface for extracting Excel metadata
 type Extractor struct {
-	file     *excelize.File
-	filename string
-	options  *Options
+	file      *excelize.File
+	filename  string
+	options   *Options
+	selection *sheetSelection
+	sampling  string
+	// parts maps sheet names to their worksheet XML parts, and states to
+	// their visibility state in the workbook part
+	parts     map[string]string
+	states    map[string]string
+	partsOnce sync.Once
+	// archive reads the parts excelize keeps out of the package
+	archive     *zip.ReadCloser
+	archiveOnce sync.Once
+	// mu serialises excelize calls that lazily populate shared state and
+	// are not safe for concurrent use
+	mu sync.Mutex
 }
 
 // Options configures the extraction behavior
@@ -27,9 +44,73 @@ type Options struct {
 	IncludeImages         bool
 	IncludeDefinedNames   bool
 	IncludeDataValidation bool
-	MaxCellsPerSheet      int
+	// IncludePasswordHash fills SheetProtection.Password with the stored
+	// password hash. It is left out by default: the legacy 16 bit hash is
+	// easily reversed to a password that unprotects the sheet.
+	IncludePasswordHash bool
+	MaxCellsPerSheet    int
+	// MaxRows limits the rows holding data whose cells are extracted, and
+	// MaxCols keeps only the leftmost columns holding data (0 = unlimited)
+	MaxRows int
+	MaxCols int
+	// Sampling chooses the rows and cells kept when MaxRows or
+	// MaxCellsPerSheet is exceeded: SampleHead (default), SampleTail,
+	// SampleStride or SampleRandom, which is seeded by SampleSeed
+	Sampling   string
+	SampleSeed int64
+	// ParseFormulas fills the Functions and References of formula cells
+	ParseFormulas bool
+	// VerifyCalculation recalculates formulas and reports stale cached
+	// values in Metadata.Calculation
+	VerifyCalculation bool
+	// AuditSecurity reports macros, external links, DDE and OLE objects,
+	// unsafe hyperlinks and hidden sheets in Metadata.Security
+	AuditSecurity bool
+	// Redact masks, hashes or drops the personal data and secrets found in
+	// the extracted metadata (RedactMask, RedactHash or RedactDrop) and
+	// always redacts sheet protection passwords. The redactions are listed
+	// in Metadata.Redactions.
+	Redact string
+	// RedactKey is the HMAC key of RedactHash. Hashes of the same value
+	// only compare equal under the same key; when empty, a random key is
+	// drawn once per process. It is required with Deterministic.
+	RedactKey []byte
+	// Deterministic makes repeated extractions of the same workbook produce
+	// byte-identical output: ExtractedAt is fixed to the zero time, Filename
+	// is reduced to its base name and all collections are sorted.
+	Deterministic bool
+	// Format selects the output format of ExtractToFile (json, yaml, toml or
+	// go). When empty it is derived from the output file extension.
+	Format string
+	// GoPackage and GoVariable name the package and variable of the source
+	// generated by ExtractToGO. They default to "main" and "metadata".
+	GoPackage  string
+	GoVariable string
+	// Concurrency is the number of sheets extracted in parallel. Values
+	// below 2 extract sheets one after another.
+	Concurrency int
+	// Sheets restricts extraction to matching sheets, all when empty. Each
+	// entry is a sheet name, a glob such as "Q*" or a regular expression
+	// wrapped in slashes such as "/^Q[1-4]$/".
+	Sheets []string
+	// ExcludeSheets skips sheets matching any of the patterns
+	ExcludeSheets []string
+	// SkipHiddenSheets skips hidden and very hidden sheets
+	SkipHiddenSheets bool
+	// Ranges restricts extraction of a sheet to a cell range, written as
+	// "Data!A1:F500", at most one per sheet. Sheets without a range are
+	// extracted completely.
+	Ranges []string
 }
 
+// Output formats supported by ExtractToFile
+const (
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+	FormatTOML = "toml"
+	FormatGo   = "go"
+)
+
 // DefaultOptions returns recommended default options
 func DefaultOptions() *Options {
 	return &Options{
@@ -39,6 +120,7 @@ func DefaultOptions() *Options {
 		IncludeDefinedNames:   true,
 		IncludeDataValidation: true,
 		MaxCellsPerSheet:      0,
+		Deterministic:         false,
 	}
 }
 
@@ -49,6 +131,9 @@ type Metadata struct {
 	Sheets       []SheetMetadata      `json:"sheets"`
 	DefinedNames []DefinedName        `json:"definedNames,omitempty"`
 	Styles       map[int]StyleDetails `json:"styles,omitempty"`
+	Calculation  *CalculationReport   `json:"calculation,omitempty"`
+	Security     *SecurityReport      `json:"security,omitempty"`
+	Redactions   *RedactionReport     `json:"redactions,omitempty"`
 	ExtractedAt  time.Time            `json:"extractedAt"`
 }
 
@@ -68,35 +153,73 @@ type DocumentProperties struct {
 
 // SheetMetadata contains metadata for a single sheet
 type SheetMetadata struct {
-	Index           int                `json:"index"`
-	Name            string             `json:"name"`
-	Visible         bool               `json:"visible"`
-	Dimensions      SheetDimensions    `json:"dimensions"`
+	Index   int    `json:"index"`
+	Name    string `json:"name"`
+	Visible bool   `json:"visible"`
+	// State is SheetHidden or SheetVeryHidden for hidden sheets; very
+	// hidden sheets can only be shown again through VBA
+	State      string          `json:"state,omitempty"`
+	Dimensions SheetDimensions `json:"dimensions"`
+	// Range is the cell range extracted with Options.Ranges, empty when
+	// the whole sheet was extracted
+	Range           string             `json:"range,omitempty"`
 	MergedCells     []MergedCell       `json:"mergedCells,omitempty"`
+	Tables          []TableMetadata    `json:"tables,omitempty"`
+	Comments        []CommentMetadata  `json:"comments,omitempty"`
 	DataValidations []DataValidation   `json:"dataValidations,omitempty"`
 	Protection      *SheetProtection   `json:"protection,omitempty"`
 	RowHeights      map[int]float64    `json:"rowHeights,omitempty"`
 	ColWidths       map[string]float64 `json:"colWidths,omitempty"`
 	Cells           []CellMetadata     `json:"cells,omitempty"`
-	Images          []ImageMetadata    `json:"images,omitempty"`
+	// TotalNonEmptyCells counts the non-empty cells of the sheet (or of its
+	// selected range), Truncated reports whether MaxRows, MaxCols or
+	// MaxCellsPerSheet left some of them out and Sampling names the
+	// strategy that chose the cells kept
+	TotalNonEmptyCells int             `json:"totalNonEmptyCells,omitempty"`
+	Truncated          bool            `json:"truncated,omitempty"`
+	Sampling           string          `json:"sampling,omitempty"`
+	Images             []ImageMetadata `json:"images,omitempty"`
 }
 
-// SheetDimensions represents the used range of a sheet
+// SheetDimensions represents the used range of a sheet. StartCell and
+// EndCell bound the cells holding data, wherever they start.
 type SheetDimensions struct {
 	StartCell string `json:"startCell"`
 	EndCell   string `json:"endCell"`
 	RowCount  int    `json:"rowCount"`
 	ColCount  int    `json:"colCount"`
+	// DeclaredRange is the <dimension ref> stored in the sheet XML
+	DeclaredRange string `json:"declaredRange,omitempty"`
+	// CellRange spans every cell stored in the sheet XML, including styled
+	// cells without a value
+	CellRange string `json:"cellRange,omitempty"`
+	// Mismatch is DimensionBloated when the declared or stored cells extend
+	// beyond the data, or DimensionStale when the declared range does not
+	// cover the data
+	Mismatch string `json:"mismatch,omitempty"`
 }
 
+// Dimension mismatches
+const (
+	DimensionBloated = "bloated"
+	DimensionStale   = "stale"
+)
+
 // CellMetadata contains metadata for a single cell
 type CellMetadata struct {
 	Address   string            `json:"address"`
 	Value     interface{}       `json:"value,omitempty"`
+	RawValue  string            `json:"rawValue,omitempty"`
 	Formula   string            `json:"formula,omitempty"`
 	StyleID   int               `json:"styleId,omitempty"`
 	Type      excelize.CellType `json:"type"`
 	Hyperlink *Hyperlink        `json:"hyperlink,omitempty"`
+	// FormulaInfo details how the formula is stored, nil without formula
+	FormulaInfo *FormulaInfo `json:"formulaInfo,omitempty"`
+	// Functions and References list what the formula uses when
+	// Options.ParseFormulas is set
+	Functions  []string `json:"functions,omitempty"`
+	References []string `json:"references,omitempty"`
 }
 
 // MergedCell represents a merged cell range
@@ -106,6 +229,21 @@ type MergedCell struct {
 	Value     string `json:"value,omitempty"`
 }
 
+// TableMetadata represents an Excel table defined on a sheet
+type TableMetadata struct {
+	Name          string `json:"name"`
+	Range         string `json:"range"`
+	StyleName     string `json:"styleName,omitempty"`
+	ShowHeaderRow bool   `json:"showHeaderRow"`
+}
+
+// CommentMetadata represents a cell comment (note)
+type CommentMetadata struct {
+	Cell   string `json:"cell"`
+	Author string `json:"author,omitempty"`
+	Text   string `json:"text"`
+}
+
 // DataValidation represents data validation rules
 type DataValidation struct {
 	Range        string  `json:"range"`
@@ -120,7 +258,9 @@ type DataValidation struct {
 
 // SheetProtection represents sheet protection settings
 type SheetProtection struct {
-	Protected           bool   `json:"protected"`
+	Protected bool `json:"protected"`
+	// Password is the stored password hash, only set with
+	// Options.IncludePasswordHash
 	Password            string `json:"password,omitempty"`
 	EditObjects         bool   `json:"editObjects"`
 	EditScenarios       bool   `json:"editScenarios"`
@@ -142,7 +282,9 @@ type StyleDetails struct {
 	Border       []BorderStyle   `json:"border,omitempty"`
 	Alignment    *AlignmentStyle `json:"alignment,omitempty"`
 	NumberFormat int             `json:"numberFormat,omitempty"`
-	Protection   *Protection     `json:"protection,omitempty"`
+	// CustomNumberFormat is the format code of a custom number format
+	CustomNumberFormat string      `json:"customNumberFormat,omitempty"`
+	Protection         *Protection `json:"protection,omitempty"`
 }
 
 // FontStyle represents font formatting
@@ -227,10 +369,34 @@ func New(filename string, options *Options) (*Extractor, error) {
 		return nil, fmt.Errorf("failed to open file: %w", err)
 	}
 
+	selection, err := newSheetSelection(options, f.GetSheetList())
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	sampling, err := samplingStrategy(options)
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	if options.Redact != "" {
+		if err := checkRedactPolicy(options.Redact); err != nil {
+			_ = f.Close()
+			return nil, err
+		}
+		// Hashes under the random per-process key differ between runs
+		if options.Deterministic && strings.EqualFold(options.Redact, RedactHash) && len(options.RedactKey) == 0 {
+			_ = f.Close()
+			return nil, fmt.Errorf("deterministic hash redaction requires a redaction key")
+		}
+	}
+
 	return &Extractor{
-		file:     f,
-		filename: filename,
-		options:  options,
+		file:      f,
+		filename:  filename,
+		options:   options,
+		selection: selection,
+		sampling:  sampling,
 	}, nil
 }
 
@@ -252,24 +418,116 @@ func (e *Extractor) Extract() (*Metadata, error) {
 		metadata.DefinedNames = e.extractDefinedNames()
 	}
 
-	// Extract sheet metadata
-	sheets := e.file.GetSheetList()
-	for idx, sheetName := range sheets {
-		sheetMeta, err := e.extractSheetMetadata(idx, sheetName)
-		if err != nil {
-			continue
+	// Extract sheet metadata, and the styles each sheet uses, possibly in
+	// parallel. Results are collected by sheet index so the output does
+	// not depend on scheduling.
+	sheets, indexes := e.selectedSheets()
+	sheetMetas := make([]*SheetMetadata, len(sheets))
+	sheetStyles := make([][]int, len(sheets))
+	e.forEachSheet(sheets, func(idx int, sheetName string) {
+		if sheetMeta, err := e.extractSheetMetadata(indexes[idx], sheetName); err == nil {
+			sheetMetas[idx] = &sheetMeta
+		}
+		if e.options.IncludeStyles {
+			sheetStyles[idx] = e.collectStyleIDs(sheetName)
+		}
+	})
+	for _, sheetMeta := range sheetMetas {
+		if sheetMeta != nil {
+			metadata.Sheets = append(metadata.Sheets, *sheetMeta)
 		}
-		metadata.Sheets = append(metadata.Sheets, sheetMeta)
 	}
 
 	// Extract unique styles if requested
 	if e.options.IncludeStyles {
-		metadata.Styles = e.extractUniqueStyles()
+		metadata.Styles = e.extractUniqueStyles(sheetStyles)
+	}
+
+	if e.options.VerifyCalculation {
+		report, err := e.VerifyCalculation()
+		if err != nil {
+			return nil, err
+		}
+		metadata.Calculation = report
+	}
+
+	if e.options.AuditSecurity {
+		report, err := e.AuditSecurity()
+		if err != nil {
+			return nil, err
+		}
+		metadata.Security = report
+	}
+
+	if e.options.Deterministic {
+		makeDeterministic(metadata)
+	}
+
+	if e.options.Redact != "" {
+		if _, err := RedactMetadata(metadata, e.options.Redact, e.options.RedactKey); err != nil {
+			return nil, err
+		}
 	}
 
 	return metadata, nil
 }
 
+// selectedSheets returns the names and workbook indexes of the sheets
+// chosen by the selection options
+func (e *Extractor) selectedSheets() ([]string, []int) {
+	var (
+		sheets  []string
+		indexes []int
+	)
+	for idx, sheetName := range e.file.GetSheetList() {
+		visible, _ := e.file.GetSheetVisible(sheetName)
+		if e.selection.selects(sheetName, visible) {
+			sheets = append(sheets, sheetName)
+			indexes = append(indexes, idx)
+		}
+	}
+	return sheets, indexes
+}
+
+// makeDeterministic strips run-dependent fields and sorts every collection
+func makeDeterministic(metadata *Metadata) {
+	metadata.ExtractedAt = time.Time{}
+	metadata.Filename = filepath.Base(metadata.Filename)
+
+	sort.SliceStable(metadata.DefinedNames, func(i, j int) bool {
+		a, b := metadata.DefinedNames[i], metadata.DefinedNames[j]
+		if a.Scope != b.Scope {
+			return a.Scope < b.Scope
+		}
+		return a.Name < b.Name
+	})
+	sort.SliceStable(metadata.Sheets, func(i, j int) bool {
+		return metadata.Sheets[i].Index < metadata.Sheets[j].Index
+	})
+
+	for i := range metadata.Sheets {
+		sheet := &metadata.Sheets[i]
+		sort.SliceStable(sheet.Cells, func(i, j int) bool {
+			return lessCellAddress(sheet.Cells[i].Address, sheet.Cells[j].Address)
+		})
+		sort.SliceStable(sheet.MergedCells, func(i, j int) bool {
+			return lessCellAddress(sheet.MergedCells[i].StartCell, sheet.MergedCells[j].StartCell)
+		})
+		sort.SliceStable(sheet.Tables, func(i, j int) bool {
+			return sheet.Tables[i].Name < sheet.Tables[j].Name
+		})
+		sort.SliceStable(sheet.Comments, func(i, j int) bool {
+			return lessCellAddress(sheet.Comments[i].Cell, sheet.Comments[j].Cell)
+		})
+		sort.SliceStable(sheet.DataValidations, func(i, j int) bool {
+			return sheet.DataValidations[i].Range < sheet.DataValidations[j].Range
+		})
+		sort.SliceStable(sheet.Images, func(i, j int) bool {
+			return lessCellAddress(sheet.Images[i].Cell, sheet.Images[j].Cell)
+		})
+	}
+}
+
 // ExtractToJSON extracts metadata and returns it as JSON string
 func (e *Extractor) ExtractToJSON(pretty bool) (string, error) {
 	metadata, err := e.Extract()
@@ -291,423 +549,85 @@ func (e *Extractor) ExtractToJSON(pretty bool) (string, error) {
 	return string(jsonData), nil
 }
 
-// ExtractToGO extracts metadata and returns it as GO string
+// ExtractToGO extracts metadata and returns it as gofmt-formatted GO source
+// using Options.GoPackage and Options.GoVariable
 func (e *Extractor) ExtractToGO() (string, error) {
 	metadata, err := e.Extract()
 	if err != nil {
 		return "", err
 	}
 
-	// Helper function to marshal Go values as Go code
-	var marshalGo func(v interface{}, indent string) string
-	marshalGo = func(v interface{}, indent string) string {
-		switch val := v.(type) {
-		case string:
-			return fmt.Sprintf("%q", val)
-		case time.Time:
-			return fmt.Sprintf("time.Date(%d, %d, %d, %d, %d, %d, %d, time.UTC)", val.Year(), val.Month(), val.Day(), val.Hour(), val.Minute(), val.Second(), val.Nanosecond())
-		case []byte:
-			return fmt.Sprintf("%#v", val)
-		case nil:
-			return "nil"
-		case bool:
-			return fmt.Sprintf("%v", val)
-		case int:
-			return fmt.Sprintf("%d", val)
-		case float64:
-			return fmt.Sprintf("%v", val)
-		case *string:
-			if val == nil {
-				return "nil"
-			}
-			return fmt.Sprintf("%v", *val)
-		case *bool:
-			if val == nil {
-				return "nil"
-			}
-			return fmt.Sprintf("%v", *val)
-		case map[int]float64:
-			if len(val) == 0 {
-				return "nil"
-			}
-			s := "map[int]float64{"
-			for k, v := range val {
-				s += fmt.Sprintf("%d: %v, ", k, v)
-			}
-			s += "}"
-			return s
-		case map[string]float64:
-			if len(val) == 0 {
-				return "nil"
-			}
-			s := "map[string]float64{"
-			for k, v := range val {
-				s += fmt.Sprintf("%q: %v, ", k, v)
-			}
-			s += "}"
-			return s
-		case map[int]StyleDetails:
-			if len(val) == 0 {
-				return "nil"
-			}
-			s := "map[int]excelmetadata.StyleDetails{\n"
-			for k, v := range val {
-				s += fmt.Sprintf("%s%d: %s,\n", indent+"  ", k, marshalGo(v, indent+"  "))
-			}
-			s += indent + "}"
-			return s
-		case []string:
-			if len(val) == 0 {
-				return "nil"
-			}
-			s := "[]string{"
-			for _, v := range val {
-				s += fmt.Sprintf("%q, ", v)
-			}
-			s += "}"
-			return s
-		case []int:
-			if len(val) == 0 {
-				return "nil"
-			}
-			s := "[]int{"
-			for _, v := range val {
-				s += fmt.Sprintf("%d, ", v)
-			}
-			s += "}"
-			return s
-		case []SheetMetadata:
-			if len(val) == 0 {
-				return "nil"
-			}
-			s := "[]excelmetadata.SheetMetadata{\n"
-			for _, v := range val {
-				s += indent + "  " + marshalGo(v, indent+"  ") + ",\n"
-			}
-			s += indent + "}"
-			return s
-		case []MergedCell:
-			if len(val) == 0 {
-				return "nil"
-			}
-			s := "[]excelmetadata.MergedCell{\n"
-			for _, v := range val {
-				s += indent + "  " + marshalGo(v, indent+"  ") + ",\n"
-			}
-			s += indent + "}"
-			return s
-		case []DataValidation:
-			if len(val) == 0 {
-				return "nil"
-			}
-			s := "[]excelmetadata.DataValidation{\n"
-			for _, v := range val {
-				s += indent + "  " + marshalGo(v, indent+"  ") + ",\n"
-			}
-			s += indent + "}"
-			return s
-		case []CellMetadata:
-			if len(val) == 0 {
-				return "nil"
-			}
-			s := "[]excelmetadata.CellMetadata{\n"
-			for _, v := range val {
-				s += indent + "  " + marshalGo(v, indent+"  ") + ",\n"
-			}
-			s += indent + "}"
-			return s
-		case []ImageMetadata:
-			if len(val) == 0 {
-				return "nil"
-			}
-			s := "[]excelmetadata.ImageMetadata{\n"
-			for _, v := range val {
-				s += indent + "  " + marshalGo(v, indent+"  ") + ",\n"
-			}
-			s += indent + "}"
-			return s
-		case []DefinedName:
-			if len(val) == 0 {
-				return "nil"
-			}
-			s := "[]excelmetadata.DefinedName{\n"
-			for _, v := range val {
-				s += indent + "  " + marshalGo(v, indent+"  ") + ",\n"
-			}
-			s += indent + "}"
-			return s
-		case *SheetProtection:
-			if val == nil {
-				return "nil"
-			}
-			return "&" + marshalGo(*val, indent)
-		case *FontStyle:
-			if val == nil {
-				return "nil"
-			}
-			return "&" + marshalGo(*val, indent)
-		case *FillStyle:
-			if val == nil {
-				return "nil"
-			}
-			return "&" + marshalGo(*val, indent)
-		case *AlignmentStyle:
-			if val == nil {
-				return "nil"
-			}
-			return "&" + marshalGo(*val, indent)
-		case *Protection:
-			if val == nil {
-				return "nil"
-			}
-			return "&" + marshalGo(*val, indent)
-		case *Hyperlink:
-			if val == nil {
-				return "nil"
-			}
-			return "&" + marshalGo(*val, indent)
-		case *ImageFormat:
-			if val == nil {
-				return "nil"
-			}
-			return "&" + marshalGo(*val, indent)
-		case StyleDetails:
-			s := "excelmetadata.StyleDetails{\n"
-			s += indent + "  Font: " + marshalGo(val.Font, indent+"  ") + ",\n"
-			s += indent + "  Fill: " + marshalGo(val.Fill, indent+"  ") + ",\n"
-			s += indent + "  Border: " + marshalGo(val.Border, indent+"  ") + ",\n"
-			s += indent + "  Alignment: " + marshalGo(val.Alignment, indent+"  ") + ",\n"
-			s += indent + "  NumberFormat: " + marshalGo(val.NumberFormat, indent+"  ") + ",\n"
-			s += indent + "  Protection: " + marshalGo(val.Protection, indent+"  ") + ",\n"
-			s += indent + "}"
-			return s
-		case FontStyle:
-			s := "excelmetadata.FontStyle{\n"
-			s += indent + "  Bold: " + marshalGo(val.Bold, indent+"  ") + ",\n"
-			s += indent + "  Italic: " + marshalGo(val.Italic, indent+"  ") + ",\n"
-			s += indent + "  Underline: " + marshalGo(val.Underline, indent+"  ") + ",\n"
-			s += indent + "  Strike: " + marshalGo(val.Strike, indent+"  ") + ",\n"
-			s += indent + "  Family: " + marshalGo(val.Family, indent+"  ") + ",\n"
-			s += indent + "  Size: " + marshalGo(val.Size, indent+"  ") + ",\n"
-			s += indent + "  Color: " + marshalGo(val.Color, indent+"  ") + ",\n"
-			s += indent + "}"
-			return s
-		case FillStyle:
-			s := "excelmetadata.FillStyle{\n"
-			s += indent + "  Type: " + marshalGo(val.Type, indent+"  ") + ",\n"
-			s += indent + "  Pattern: " + marshalGo(val.Pattern, indent+"  ") + ",\n"
-			s += indent + "  Color: " + marshalGo(val.Color, indent+"  ") + ",\n"
-			s += indent + "}"
-			return s
-		case []BorderStyle:
-			if len(val) == 0 {
-				return "nil"
-			}
-			s := "[]excelmetadata.BorderStyle{\n"
-			for _, v := range val {
-				s += indent + "  " + marshalGo(v, indent+"  ") + ",\n"
-			}
-			s += indent + "}"
-			return s
-		case BorderStyle:
-			s := "excelmetadata.BorderStyle{\n"
-			s += indent + "  Type: " + marshalGo(val.Type, indent+"  ") + ",\n"
-			s += indent + "  Color: " + marshalGo(val.Color, indent+"  ") + ",\n"
-			s += indent + "  Style: " + marshalGo(val.Style, indent+"  ") + ",\n"
-			s += indent + "}"
-			return s
-		case AlignmentStyle:
-			s := "excelmetadata.AlignmentStyle{\n"
-			s += indent + "  Horizontal: " + marshalGo(val.Horizontal, indent+"  ") + ",\n"
-			s += indent + "  Vertical: " + marshalGo(val.Vertical, indent+"  ") + ",\n"
-			s += indent + "  WrapText: " + marshalGo(val.WrapText, indent+"  ") + ",\n"
-			s += indent + "  TextRotation: " + marshalGo(val.TextRotation, indent+"  ") + ",\n"
-			s += indent + "  Indent: " + marshalGo(val.Indent, indent+"  ") + ",\n"
-			s += indent + "  ShrinkToFit: " + marshalGo(val.ShrinkToFit, indent+"  ") + ",\n"
-			s += indent + "}"
-			return s
-		case Protection:
-			s := "excelmetadata.Protection{\n"
-			s += indent + "  Hidden: " + marshalGo(val.Hidden, indent+"  ") + ",\n"
-			s += indent + "  Locked: " + marshalGo(val.Locked, indent+"  ") + ",\n"
-			s += indent + "}"
-			return s
-		case SheetMetadata:
-			s := "excelmetadata.SheetMetadata{\n"
-			s += indent + "  Index: " + marshalGo(val.Index, indent+"  ") + ",\n"
-			s += indent + "  Name: " + marshalGo(val.Name, indent+"  ") + ",\n"
-			s += indent + "  Visible: " + marshalGo(val.Visible, indent+"  ") + ",\n"
-			s += indent + "  Dimensions: " + marshalGo(val.Dimensions, indent+"  ") + ",\n"
-			s += indent + "  MergedCells: " + marshalGo(val.MergedCells, indent+"  ") + ",\n"
-			s += indent + "  DataValidations: " + marshalGo(val.DataValidations, indent+"  ") + ",\n"
-			s += indent + "  Protection: " + marshalGo(val.Protection, indent+"  ") + ",\n"
-			s += indent + "  RowHeights: " + marshalGo(val.RowHeights, indent+"  ") + ",\n"
-			s += indent + "  ColWidths: " + marshalGo(val.ColWidths, indent+"  ") + ",\n"
-			s += indent + "  Cells: " + marshalGo(val.Cells, indent+"  ") + ",\n"
-			s += indent + "  Images: " + marshalGo(val.Images, indent+"  ") + ",\n"
-			s += indent + "}"
-			return s
-		case SheetDimensions:
-			s := "excelmetadata.SheetDimensions{\n"
-			s += indent + "  StartCell: " + marshalGo(val.StartCell, indent+"  ") + ",\n"
-			s += indent + "  EndCell: " + marshalGo(val.EndCell, indent+"  ") + ",\n"
-			s += indent + "  RowCount: " + marshalGo(val.RowCount, indent+"  ") + ",\n"
-			s += indent + "  ColCount: " + marshalGo(val.ColCount, indent+"  ") + ",\n"
-			s += indent + "}"
-			return s
-		case MergedCell:
-			s := "excelmetadata.MergedCell{\n"
-			s += indent + "  StartCell: " + marshalGo(val.StartCell, indent+"  ") + ",\n"
-			s += indent + "  EndCell: " + marshalGo(val.EndCell, indent+"  ") + ",\n"
-			s += indent + "  Value: " + marshalGo(val.Value, indent+"  ") + ",\n"
-			s += indent + "}"
-			return s
-		case DataValidation:
-			s := "excelmetadata.DataValidation{\n"
-			s += indent + "  Range: " + marshalGo(val.Range, indent+"  ") + ",\n"
-			s += indent + "  Type: " + marshalGo(val.Type, indent+"  ") + ",\n"
-			s += indent + "  Operator: " + marshalGo(val.Operator, indent+"  ") + ",\n"
-			s += indent + "  Formula1: " + marshalGo(val.Formula1, indent+"  ") + ",\n"
-			s += indent + "  Formula2: " + marshalGo(val.Formula2, indent+"  ") + ",\n"
-			s += indent + "  ShowError: " + marshalGo(val.ShowError, indent+"  ") + ",\n"
-			s += indent + "  ErrorTitle: " + marshalGo(val.ErrorTitle, indent+"  ") + ",\n"
-			s += indent + "  ErrorMessage: " + marshalGo(val.ErrorMessage, indent+"  ") + ",\n"
-			s += indent + "}"
-			return s
-		case SheetProtection:
-			s := "excelmetadata.SheetProtection{\n"
-			s += indent + "  Protected: " + marshalGo(val.Protected, indent+"  ") + ",\n"
-			s += indent + "  Password: " + marshalGo(val.Password, indent+"  ") + ",\n"
-			s += indent + "  EditObjects: " + marshalGo(val.EditObjects, indent+"  ") + ",\n"
-			s += indent + "  EditScenarios: " + marshalGo(val.EditScenarios, indent+"  ") + ",\n"
-			s += indent + "  SelectLockedCells: " + marshalGo(val.SelectLockedCells, indent+"  ") + ",\n"
-			s += indent + "  SelectUnlockedCells: " + marshalGo(val.SelectUnlockedCells, indent+"  ") + ",\n"
-			s += indent + "}"
-			return s
-		case CellMetadata:
-			s := "excelmetadata.CellMetadata{\n"
-			s += indent + "  Address: " + marshalGo(val.Address, indent+"  ") + ",\n"
-			s += indent + "  Value: " + marshalGo(val.Value, indent+"  ") + ",\n"
-			s += indent + "  Formula: " + marshalGo(val.Formula, indent+"  ") + ",\n"
-			s += indent + "  StyleID: " + marshalGo(val.StyleID, indent+"  ") + ",\n"
-			s += indent + "  Type: " + strings.ReplaceAll(fmt.Sprintf("excelize.CellType('%q')", string(val.Type)), "\"", "") + ",\n"
-			s += indent + "  Hyperlink: " + marshalGo(val.Hyperlink, indent+"  ") + ",\n"
-			s += indent + "}"
-			return s
-		case Hyperlink:
-			s := "excelmetadata.Hyperlink{\n"
-			s += indent + "  Link: " + marshalGo(val.Link, indent+"  ") + ",\n"
-			s += indent + "}"
-			return s
-		case ImageMetadata:
-			s := "excelmetadata.ImageMetadata{\n"
-			s += indent + "  Cell: " + marshalGo(val.Cell, indent+"  ") + ",\n"
-			s += indent + "  File: " + marshalGo(val.File, indent+"  ") + ",\n"
-			s += indent + "  Extension: " + marshalGo(val.Extension, indent+"  ") + ",\n"
-			s += indent + "  InsertType: " + fmt.Sprintf("%#v", val.InsertType) + ",\n"
-			s += indent + "  Format: " + marshalGo(val.Format, indent+"  ") + ",\n"
-			s += indent + "}"
-			return s
-		case ImageFormat:
-			s := "excelmetadata.ImageFormat{\n"
-			s += indent + "  AltText: " + marshalGo(val.AltText, indent+"  ") + ",\n"
-			s += indent + "  PrintObject: " + marshalGo(val.PrintObject, indent+"  ") + ",\n"
-			s += indent + "  Locked: " + marshalGo(val.Locked, indent+"  ") + ",\n"
-			s += indent + "  LockAspectRatio: " + marshalGo(val.LockAspectRatio, indent+"  ") + ",\n"
-			s += indent + "  AutoFit: " + marshalGo(val.AutoFit, indent+"  ") + ",\n"
-			s += indent + "  AutoFitIgnoreAspect: " + marshalGo(val.AutoFitIgnoreAspect, indent+"  ") + ",\n"
-			s += indent + "  OffsetX: " + marshalGo(val.OffsetX, indent+"  ") + ",\n"
-			s += indent + "  OffsetY: " + marshalGo(val.OffsetY, indent+"  ") + ",\n"
-			s += indent + "  ScaleX: " + marshalGo(val.ScaleX, indent+"  ") + ",\n"
-			s += indent + "  ScaleY: " + marshalGo(val.ScaleY, indent+"  ") + ",\n"
-			s += indent + "  Hyperlink: " + marshalGo(val.Hyperlink, indent+"  ") + ",\n"
-			s += indent + "  HyperlinkType: " + marshalGo(val.HyperlinkType, indent+"  ") + ",\n"
-			s += indent + "  Positioning: " + marshalGo(val.Positioning, indent+"  ") + ",\n"
-			s += indent + "}"
-			return s
-		case DefinedName:
-			s := "excelmetadata.DefinedName{\n"
-			s += indent + "  Name: " + marshalGo(val.Name, indent+"  ") + ",\n"
-			s += indent + "  RefersTo: " + marshalGo(val.RefersTo, indent+"  ") + ",\n"
-			s += indent + "  Scope: " + marshalGo(val.Scope, indent+"  ") + ",\n"
-			s += indent + "}"
-			return s
-		case DocumentProperties:
-			s := "excelmetadata.DocumentProperties{\n"
-			s += indent + "  Title: " + marshalGo(val.Title, indent+"  ") + ",\n"
-			s += indent + "  Subject: " + marshalGo(val.Subject, indent+"  ") + ",\n"
-			s += indent + "  Creator: " + marshalGo(val.Creator, indent+"  ") + ",\n"
-			s += indent + "  Keywords: " + marshalGo(val.Keywords, indent+"  ") + ",\n"
-			s += indent + "  Description: " + marshalGo(val.Description, indent+"  ") + ",\n"
-			s += indent + "  LastModifiedBy: " + marshalGo(val.LastModifiedBy, indent+"  ") + ",\n"
-			s += indent + "  Category: " + marshalGo(val.Category, indent+"  ") + ",\n"
-			s += indent + "  Version: " + marshalGo(val.Version, indent+"  ") + ",\n"
-			s += indent + "  Created: " + marshalGo(val.Created, indent+"  ") + ",\n"
-			s += indent + "  Modified: " + marshalGo(val.Modified, indent+"  ") + ",\n"
-			s += indent + "}"
-			return s
-		case Metadata:
-			s := "excelmetadata.Metadata{\n"
-			s += indent + "  Filename: " + marshalGo(val.Filename, indent+"  ") + ",\n"
-			s += indent + "  Properties: " + marshalGo(val.Properties, indent+"  ") + ",\n"
-			s += indent + "  Sheets: " + marshalGo(val.Sheets, indent+"  ") + ",\n"
-			s += indent + "  DefinedNames: " + marshalGo(val.DefinedNames, indent+"  ") + ",\n"
-			s += indent + "  Styles: " + marshalGo(val.Styles, indent+"  ") + ",\n"
-			s += indent + "  ExtractedAt: " + marshalGo(val.ExtractedAt, indent+"  ") + ",\n"
-			s += indent + "}"
-			return s
-		default:
-			return fmt.Sprintf("%#v", v)
-		}
+	src, err := MarshalGo(metadata, e.options.GoPackage, e.options.GoVariable)
+	if err != nil {
+		return "", err
 	}
 
-	goStr := fmt.Sprintf(`package main
+	return string(src), nil
+}
 
-import (
-	"github.com/prongbang/excelmetadata"
-	"github.com/prongbang/excelrecreator"
-	"github.com/xuri/excelize/v2"
-)
+// ExtractToYAML extracts metadata and returns it as YAML string
+func (e *Extractor) ExtractToYAML() (string, error) {
+	metadata, err := e.Extract()
+	if err != nil {
+		return "", err
+	}
 
-func main() {
-	f := excelize.NewFile()
+	data, err := MarshalYAML(metadata)
+	if err != nil {
+		return "", err
+	}
 
-	metadata := &%s
+	return string(data), nil
+}
 
-	reCreator := &excelrecreator.Recreator{
-		File:     f,
-		Metadata: metadata,
-		Options:  excelrecreator.DefaultOptions(),
-		StyleMap: make(map[int]int),
+// ExtractToTOML extracts metadata and returns it as TOML string
+func (e *Extractor) ExtractToTOML() (string, error) {
+	metadata, err := e.Extract()
+	if err != nil {
+		return "", err
 	}
-	_ = reCreator.Recreate()
 
-	_ = f.SaveAs("sample.clone.xlsx")
-}`,
-		marshalGo(*metadata, ""),
-	)
+	data, err := MarshalTOML(metadata)
+	if err != nil {
+		return "", err
+	}
 
-	return goStr, nil
+	return string(data), nil
 }
 
-// ExtractToFile extracts metadata and saves it to a JSON or GO file
+// ExtractTo extracts metadata and writes it to w with the encoder
+// registered under format
+func (e *Extractor) ExtractTo(w io.Writer, format string, pretty bool) error {
+	enc, ok := LookupEncoder(format)
+	if !ok {
+		return fmt.Errorf("unsupported format %q", format)
+	}
+
+	metadata, err := e.Extract()
+	if err != nil {
+		return err
+	}
+
+	return enc.Encode(w, metadata, e.encodeOptions(pretty))
+}
+
+// ExtractToFile extracts metadata and saves it with a registered encoder.
+// The format is Options.Format when set, otherwise the one registered for
+// the file extension.
 func (e *Extractor) ExtractToFile(outputPath string, pretty bool) error {
-	ext := path.Ext(outputPath)
-	var data []byte
-	if ext == ".json" {
-		jsonStr, err := e.ExtractToJSON(pretty)
-		if err != nil {
-			return err
+	format := e.options.Format
+	if format == "" {
+		ext := path.Ext(outputPath)
+		name, ok := FormatForExtension(ext)
+		if !ok {
+			return fmt.Errorf("unsupported %s file", strings.TrimPrefix(ext, "."))
 		}
-		data = []byte(jsonStr)
-	} else if ext == ".go" {
-		goStr, err := e.ExtractToGO()
-		if err != nil {
-			return err
-		}
-		data = []byte(goStr)
-	} else {
-		return errors.New(fmt.Sprintf("unsupported %s file", ext))
+		format = name
+	}
+
+	var buf bytes.Buffer
+	if err := e.ExtractTo(&buf, format, pretty); err != nil {
+		return err
 	}
 
 	dir := filepath.Dir(outputPath)
@@ -715,11 +635,22 @@ func (e *Extractor) ExtractToFile(outputPath string, pretty bool) error {
 		return err
 	}
 
-	return os.WriteFile(outputPath, data, 0644)
+	return os.WriteFile(outputPath, buf.Bytes(), 0644)
+}
+
+func (e *Extractor) encodeOptions(pretty bool) *EncodeOptions {
+	return &EncodeOptions{
+		Pretty:     pretty,
+		GoPackage:  e.options.GoPackage,
+		GoVariable: e.options.GoVariable,
+	}
 }
 
 // Close closes the underlying Excel file
 func (e *Extractor) Close() error {
+	if e.archive != nil {
+		_ = e.archive.Close()
+	}
 	return e.file.Close()
 }
 
@@ -751,6 +682,13 @@ func (e *Extractor) extractDefinedNames() []DefinedName {
 	// GetDefinedName returns []DefinedName
 	definedNames := e.file.GetDefinedName()
 	for _, dn := range definedNames {
+		// Names local to a sheet follow the sheet selection
+		if dn.Scope != "" && dn.Scope != "Workbook" {
+			visible, _ := e.file.GetSheetVisible(dn.Scope)
+			if !e.selection.selects(dn.Scope, visible) {
+				continue
+			}
+		}
 		names = append(names, DefinedName{
 			Name:     dn.Name,
 			RefersTo: dn.RefersTo,
@@ -762,11 +700,18 @@ func (e *Extractor) extractDefinedNames() []DefinedName {
 }
 
 func (e *Extractor) extractSheetMetadata(index int, sheetName string) (SheetMetadata, error) {
+	// Sheet level parts are read under the lock, cells are read concurrently
+	e.mu.Lock()
 	visible, _ := e.file.GetSheetVisible(sheetName)
 	sheet := SheetMetadata{
 		Index:   index,
 		Name:    sheetName,
 		Visible: visible,
+		State:   e.sheetState(sheetName),
+	}
+	bounds := e.selection.rangeOf(sheetName)
+	if bounds != nil {
+		sheet.Range = bounds.String()
 	}
 
 	// Get sheet dimensions
@@ -774,9 +719,17 @@ func (e *Extractor) extractSheetMetadata(index int, sheetName string) (SheetMeta
 		sheet.Dimensions = dimensions
 	}
 
+	// Extract sheet protection
+	if part, ok := e.sheetParts()[sheetName]; ok {
+		sheet.Protection = scanSheetProtection(e.readPart(part), e.options.IncludePasswordHash)
+	}
+
 	// Extract merged cells
 	if mergedCells, err := e.file.GetMergeCells(sheetName); err == nil {
 		for _, mc := range mergedCells {
+			if !bounds.overlaps(mc.GetStartAxis() + ":" + mc.GetEndAxis()) {
+				continue
+			}
 			sheet.MergedCells = append(sheet.MergedCells, MergedCell{
 				StartCell: mc.GetStartAxis(),
 				EndCell:   mc.GetEndAxis(),
@@ -785,11 +738,51 @@ func (e *Extractor) extractSheetMetadata(index int, sheetName string) (SheetMeta
 		}
 	}
 
+	// Extract tables
+	if tables, err := e.file.GetTables(sheetName); err == nil {
+		for _, tbl := range tables {
+			if !bounds.overlaps(tbl.Range) {
+				continue
+			}
+			sheet.Tables = append(sheet.Tables, TableMetadata{
+				Name:          tbl.Name,
+				Range:         tbl.Range,
+				StyleName:     tbl.StyleName,
+				ShowHeaderRow: tbl.ShowHeaderRow == nil || *tbl.ShowHeaderRow,
+			})
+		}
+	}
+
+	// Extract comments
+	if comments, err := e.file.GetComments(sheetName); err == nil {
+		for _, c := range comments {
+			if !bounds.containsCell(c.Cell) {
+				continue
+			}
+			text := c.Text
+			if text == "" {
+				var sb strings.Builder
+				for _, run := range c.Paragraph {
+					sb.WriteString(run.Text)
+				}
+				text = sb.String()
+			}
+			sheet.Comments = append(sheet.Comments, CommentMetadata{
+				Cell:   c.Cell,
+				Author: c.Author,
+				Text:   text,
+			})
+		}
+	}
+
 	// Extract data validations
 	if e.options.IncludeDataValidation {
 		// GetDataValidations returns ([]*DataValidation, error)
 		if dvs, err := e.file.GetDataValidations(sheetName); err == nil {
 			for _, dv := range dvs {
+				if !bounds.overlaps(dv.Sqref) {
+					continue
+				}
 				sheet.DataValidations = append(sheet.DataValidations, DataValidation{
 					Range:        dv.Sqref,
 					Type:         dv.Type,
@@ -811,24 +804,27 @@ func (e *Extractor) extractSheetMetadata(index int, sheetName string) (SheetMeta
 	// Get column widths
 	cols, _ := e.file.GetCols(sheetName)
 	for idx := range cols {
+		if !bounds.containsCol(idx + 1) {
+			continue
+		}
 		col, _ := excelize.ColumnNumberToName(idx + 1)
 		width, _ := e.file.GetColWidth(sheetName, col)
 		if width != 9.140625 { // default width
 			sheet.ColWidths[col] = width
 		}
 	}
+	e.mu.Unlock()
 
 	// Extract cell data
 	if e.options.IncludeCellData {
-		cells, err := e.extractCellData(sheetName)
-		if err == nil {
-			sheet.Cells = cells
-		}
+		_ = e.extractCellData(&sheet)
 	}
 
 	// Extract images
 	if e.options.IncludeImages {
+		e.mu.Lock()
 		sheet.Images = e.extractImages(sheetName)
+		e.mu.Unlock()
 	}
 
 	return sheet, nil
@@ -840,91 +836,199 @@ func (e *Extractor) getSheetDimensions(sheetName string) (SheetDimensions, error
 		return SheetDimensions{}, err
 	}
 
-	rowCount := len(rows)
-	maxColCount := 0
-
-	for _, row := range rows {
-		if len(row) > maxColCount {
-			maxColCount = len(row)
+	// Bounds of the non-empty cells
+	minRow, minCol, maxRow, maxCol := 0, 0, 0, 0
+	for rowIdx, row := range rows {
+		for colIdx, value := range row {
+			if value == "" {
+				continue
+			}
+			if minRow == 0 {
+				minRow = rowIdx + 1
+			}
+			if minCol == 0 || colIdx+1 < minCol {
+				minCol = colIdx + 1
+			}
+			maxRow = rowIdx + 1
+			if colIdx+1 > maxCol {
+				maxCol = colIdx + 1
+			}
 		}
 	}
 
-	if rowCount == 0 || maxColCount == 0 {
-		return SheetDimensions{
-			StartCell: "A1",
-			EndCell:   "A1",
-			RowCount:  0,
-			ColCount:  0,
-		}, nil
+	dimensions := SheetDimensions{
+		StartCell: "A1",
+		EndCell:   "A1",
+	}
+	if maxRow > 0 {
+		dimensions.StartCell, _ = excelize.CoordinatesToCellName(minCol, minRow)
+		dimensions.EndCell, _ = excelize.CoordinatesToCellName(maxCol, maxRow)
+		dimensions.RowCount = maxRow - minRow + 1
+		dimensions.ColCount = maxCol - minCol + 1
 	}
 
-	startCell := "A1"
-	endCol, _ := excelize.ColumnNumberToName(maxColCount)
-	endCell := fmt.Sprintf("%s%d", endCol, rowCount)
+	if ref, err := e.file.GetSheetDimension(sheetName); err == nil {
+		dimensions.DeclaredRange = ref
+	}
+	if part, ok := e.sheetParts()[sheetName]; ok {
+		dimensions.CellRange = scanCellBounds(e.readPart(part))
+	}
+	dimensions.Mismatch = dimensionMismatch(dimensions)
 
-	return SheetDimensions{
-		StartCell: startCell,
-		EndCell:   endCell,
-		RowCount:  rowCount,
-		ColCount:  maxColCount,
-	}, nil
+	return dimensions, nil
 }
 
-func (e *Extractor) extractCellData(sheetName string) ([]CellMetadata, error) {
-	var cells []CellMetadata
-	cellCount := 0
+// dimensionMismatch compares the declared and stored ranges of a sheet with
+// the range holding data
+func dimensionMismatch(dimensions SheetDimensions) string {
+	var data *cellRange
+	if dimensions.RowCount > 0 {
+		r, err := parseCellRange(dimensions.StartCell + ":" + dimensions.EndCell)
+		if err != nil {
+			return ""
+		}
+		data = &r
+	}
 
-	rows, err := e.file.GetRows(sheetName)
+	bloated := false
+	for _, ref := range []string{dimensions.DeclaredRange, dimensions.CellRange} {
+		if ref == "" {
+			continue
+		}
+		r, err := parseCellRange(ref)
+		if err != nil {
+			continue
+		}
+		if ref == dimensions.DeclaredRange && data != nil && !r.covers(*data) {
+			return DimensionStale
+		}
+		// A lone A1 is what an empty sheet declares
+		if data == nil && ref != "A1" && ref != "A1:A1" || data != nil && !data.covers(r) {
+			bloated = true
+		}
+	}
+	if bloated {
+		return DimensionBloated
+	}
+	return ""
+}
+
+// extractCellData fills the cells of a sheet, applying the row, column and
+// cell limits with the configured sampling strategy
+func (e *Extractor) extractCellData(sheet *SheetMetadata) error {
+	rows, err := e.file.GetRows(sheet.Name)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	rawRows, err := e.file.GetRows(sheet.Name, excelize.Options{RawCellValue: true})
+	if err != nil {
+		return err
 	}
 
+	// Collect the non-empty cells, then decide which of them to keep
+	type position struct{ row, col int }
+	var (
+		positions []position
+		dataRows  []int
+		dataCols  = make(map[int]bool)
+	)
+	bounds := e.selection.rangeOf(sheet.Name)
 	for rowIdx, row := range rows {
+		hasData := false
 		for colIdx, value := range row {
-			if value == "" {
+			if value == "" || !bounds.contains(colIdx+1, rowIdx+1) {
 				continue
 			}
+			positions = append(positions, position{rowIdx, colIdx})
+			dataCols[colIdx] = true
+			hasData = true
+		}
+		if hasData {
+			dataRows = append(dataRows, rowIdx)
+		}
+	}
+	sheet.TotalNonEmptyCells = len(positions)
 
-			if e.options.MaxCellsPerSheet > 0 && cellCount >= e.options.MaxCellsPerSheet {
-				return cells, nil
-			}
+	keepRows := make(map[int]bool)
+	for _, i := range sampleIndexes(len(dataRows), e.options.MaxRows, e.sampling, e.options.SampleSeed) {
+		keepRows[dataRows[i]] = true
+	}
+	// MaxCols keeps the leftmost columns holding data
+	cols := make([]int, 0, len(dataCols))
+	for col := range dataCols {
+		cols = append(cols, col)
+	}
+	sort.Ints(cols)
+	if e.options.MaxCols > 0 && len(cols) > e.options.MaxCols {
+		cols = cols[:e.options.MaxCols]
+	}
+	keepCols := make(map[int]bool)
+	for _, col := range cols {
+		keepCols[col] = true
+	}
 
-			col, _ := excelize.ColumnNumberToName(colIdx + 1)
-			cellAddr := fmt.Sprintf("%s%d", col, rowIdx+1)
+	kept := positions[:0]
+	for _, p := range positions {
+		if keepRows[p.row] && keepCols[p.col] {
+			kept = append(kept, p)
+		}
+	}
+	indexes := sampleIndexes(len(kept), e.options.MaxCellsPerSheet, e.sampling, e.options.SampleSeed)
+	if len(indexes) < sheet.TotalNonEmptyCells {
+		sheet.Truncated = true
+		sheet.Sampling = e.sampling
+	}
 
-			cellMeta := CellMetadata{
-				Address: cellAddr,
-				Value:   value,
-			}
+	formulas := e.sheetFormulas(sheet.Name)
+	for _, i := range indexes {
+		rowIdx, colIdx := kept[i].row, kept[i].col
+		value := rows[rowIdx][colIdx]
+		col, _ := excelize.ColumnNumberToName(colIdx + 1)
+		cellAddr := fmt.Sprintf("%s%d", col, rowIdx+1)
 
-			// Get formula
-			if formula, err := e.file.GetCellFormula(sheetName, cellAddr); err == nil && formula != "" {
-				cellMeta.Formula = formula
-			}
-
-			// Get style ID
-			if styleID, err := e.file.GetCellStyle(sheetName, cellAddr); err == nil {
-				cellMeta.StyleID = styleID
-			}
+		cellMeta := CellMetadata{
+			Address: cellAddr,
+			Value:   value,
+		}
 
-			// Get cell type
-			if cellType, err := e.file.GetCellType(sheetName, cellAddr); err == nil {
-				cellMeta.Type = cellType
-			}
+		// Keep the unformatted value only when number formatting changed it
+		if rowIdx < len(rawRows) && colIdx < len(rawRows[rowIdx]) && rawRows[rowIdx][colIdx] != value {
+			cellMeta.RawValue = rawRows[rowIdx][colIdx]
+		}
 
-			// Get hyperlink - GetCellHyperLink returns (HyperlinkOpts, string, error)
-			if link, target, err := e.file.GetCellHyperLink(sheetName, cellAddr); err == nil && link {
-				cellMeta.Hyperlink = &Hyperlink{
-					Link: target,
+		// Get formula
+		if formula, err := e.file.GetCellFormula(sheet.Name, cellAddr); err == nil && formula != "" {
+			cellMeta.Formula = formula
+			if e.options.ParseFormulas {
+				if ast, err := ParseFormula(formula); err == nil {
+					cellMeta.Functions = ast.Functions()
+					cellMeta.References = ast.References()
 				}
 			}
+		}
+		cellMeta.FormulaInfo = formulas[cellAddr]
+
+		// Get style ID
+		if styleID, err := e.file.GetCellStyle(sheet.Name, cellAddr); err == nil {
+			cellMeta.StyleID = styleID
+		}
 
-			cells = append(cells, cellMeta)
-			cellCount++
+		// Get cell type
+		if cellType, err := e.file.GetCellType(sheet.Name, cellAddr); err == nil {
+			cellMeta.Type = cellType
 		}
+
+		// Get hyperlink - GetCellHyperLink returns (HyperlinkOpts, string, error)
+		if link, target, err := e.file.GetCellHyperLink(sheet.Name, cellAddr); err == nil && link {
+			cellMeta.Hyperlink = &Hyperlink{
+				Link: target,
+			}
+		}
+
+		sheet.Cells = append(sheet.Cells, cellMeta)
 	}
 
-	return cells, nil
+	return nil
 }
 
 func (e *Extractor) extractImages(sheetName string) []ImageMetadata {
@@ -934,7 +1038,11 @@ func (e *Extractor) extractImages(sheetName string) []ImageMetadata {
 	if err != nil {
 		return images
 	}
+	bounds := e.selection.rangeOf(sheetName)
 	for _, cellAddr := range cellAddress {
+		if !bounds.containsCell(cellAddr) {
+			continue
+		}
 		// GetPictures returns ([]Picture, error)
 		pictures, err := e.file.GetPictures(sheetName, cellAddr)
 		if err != nil {
@@ -973,29 +1081,79 @@ func (e *Extractor) extractImages(sheetName string) []ImageMetadata {
 	return images
 }
 
-func (e *Extractor) extractUniqueStyles() map[int]StyleDetails {
-	styles := make(map[int]StyleDetails)
-	processedStyles := make(map[int]bool)
+// forEachSheet calls fn for every sheet, on up to Options.Concurrency
+// goroutines
+func (e *Extractor) forEachSheet(sheets []string, fn func(idx int, sheetName string)) {
+	workers := e.options.Concurrency
+	if workers > len(sheets) {
+		workers = len(sheets)
+	}
+	if workers <= 1 {
+		for idx, sheetName := range sheets {
+			fn(idx, sheetName)
+		}
+		return
+	}
 
-	for _, sheetName := range e.file.GetSheetList() {
-		rows, err := e.file.GetRows(sheetName)
-		if err != nil {
-			continue
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				fn(idx, sheets[idx])
+			}
+		}()
+	}
+	for idx := range sheets {
+		jobs <- idx
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+// collectStyleIDs returns the non-default style IDs used by cells of a sheet
+func (e *Extractor) collectStyleIDs(sheetName string) []int {
+	rows, err := e.file.GetRows(sheetName)
+	if err != nil {
+		return nil
+	}
+
+	bounds := e.selection.rangeOf(sheetName)
+	seen := make(map[int]bool)
+	var ids []int
+	for rowIdx, row := range rows {
+		for colIdx := range row {
+			if !bounds.contains(colIdx+1, rowIdx+1) {
+				continue
+			}
+			col, _ := excelize.ColumnNumberToName(colIdx + 1)
+			cellAddr := fmt.Sprintf("%s%d", col, rowIdx+1)
+
+			if styleID, err := e.file.GetCellStyle(sheetName, cellAddr); err == nil && styleID != 0 && !seen[styleID] {
+				seen[styleID] = true
+				ids = append(ids, styleID)
+			}
 		}
+	}
+	return ids
+}
 
-		for rowIdx, row := range rows {
-			for colIdx := range row {
-				col, _ := excelize.ColumnNumberToName(colIdx + 1)
-				cellAddr := fmt.Sprintf("%s%d", col, rowIdx+1)
-
-				if styleID, err := e.file.GetCellStyle(sheetName, cellAddr); err == nil && styleID != 0 {
-					if !processedStyles[styleID] {
-						if style, err := e.extractStyleDetails(styleID); err == nil {
-							styles[styleID] = style
-							processedStyles[styleID] = true
-						}
-					}
-				}
+// extractUniqueStyles resolves the style IDs collected per sheet, in sheet
+// order
+func (e *Extractor) extractUniqueStyles(sheetStyles [][]int) map[int]StyleDetails {
+	styles := make(map[int]StyleDetails)
+	processedStyles := make(map[int]bool)
+
+	for _, ids := range sheetStyles {
+		for _, styleID := range ids {
+			if processedStyles[styleID] {
+				continue
+			}
+			processedStyles[styleID] = true
+			if style, err := e.extractStyleDetails(styleID); err == nil {
+				styles[styleID] = style
 			}
 		}
 	}
@@ -1013,6 +1171,9 @@ func (e *Extractor) extractStyleDetails(styleID int) (StyleDetails, error) {
 	details := StyleDetails{
 		NumberFormat: style.NumFmt,
 	}
+	if style.CustomNumFmt != nil {
+		details.CustomNumberFormat = *style.CustomNumFmt
+	}
 
 	// Extract font details
 	if style.Font != nil {
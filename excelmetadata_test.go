@@ -0,0 +1,67 @@
+package excelmetadata_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prongbang/excelmetadata"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestDeterministicOutput(t *testing.T) {
+	filename := newWorkbook(t, func(f *excelize.File) {
+		for i, width := range []float64{12, 20, 30} {
+			col, _ := excelize.ColumnNumberToName(i + 1)
+			_ = f.SetColWidth("Sheet1", col, col, width)
+			_ = f.SetCellValue("Sheet1", col+"1", width)
+		}
+		bold, _ := f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+		italic, _ := f.NewStyle(&excelize.Style{Font: &excelize.Font{Italic: true}})
+		_ = f.SetCellStyle("Sheet1", "A1", "A1", bold)
+		_ = f.SetCellStyle("Sheet1", "B1", "B1", italic)
+	})
+
+	// A copy in another directory must produce the same output
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other := filepath.Join(t.TempDir(), filepath.Base(filename))
+	if err := os.WriteFile(other, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	render := func(filename string) (string, string) {
+		options := excelmetadata.DefaultOptions()
+		options.Deterministic = true
+		extractor, err := excelmetadata.New(filename, options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			_ = extractor.Close()
+		}()
+
+		jsonStr, err := extractor.ExtractToJSON(true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		goStr, err := extractor.ExtractToGO()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return jsonStr, goStr
+	}
+
+	firstJSON, firstGo := render(filename)
+	for i := 0; i < 5; i++ {
+		jsonStr, goStr := render(other)
+		if jsonStr != firstJSON {
+			t.Fatalf("JSON output differs between runs:\n%s\n%s", firstJSON, jsonStr)
+		}
+		if goStr != firstGo {
+			t.Fatalf("Go output differs between runs:\n%s\n%s", firstGo, goStr)
+		}
+	}
+}
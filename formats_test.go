@@ -0,0 +1,101 @@
+package excelmetadata_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/prongbang/excelmetadata"
+	"github.com/xuri/excelize/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// normalize converts a decoded document into its JSON form without nulls
+func normalize(t *testing.T, v interface{}) interface{} {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out interface{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	return dropNulls(out)
+}
+
+func dropNulls(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, item := range val {
+			if item == nil {
+				delete(val, k)
+			} else {
+				val[k] = dropNulls(item)
+			}
+		}
+	case []interface{}:
+		for i, item := range val {
+			val[i] = dropNulls(item)
+		}
+	}
+	return v
+}
+
+func TestMarshalYAMLAndTOML(t *testing.T) {
+	metadata := extract(t, newWorkbook(t, func(f *excelize.File) {
+		bold, _ := f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+		_ = f.SetCellValue("Sheet1", "A1", "Name \"quoted\"\nnext line")
+		_ = f.SetCellStyle("Sheet1", "A1", "A1", bold)
+		_ = f.SetCellValue("Sheet1", "B2", 12.5)
+		_ = f.SetColWidth("Sheet1", "B", "B", 20)
+		_ = f.MergeCell("Sheet1", "C1", "D1")
+		_, _ = f.NewSheet("Data Sheet")
+		_ = f.SetCellValue("Data Sheet", "A1", true)
+		_ = f.SetDefinedName(&excelize.DefinedName{Name: "Names", RefersTo: "Sheet1!$A$1"})
+	}))
+	want := normalize(t, metadata)
+
+	yamlData, err := excelmetadata.MarshalYAML(metadata)
+	if err != nil {
+		t.Fatalf("MarshalYAML() error = %v", err)
+	}
+	var fromYAML map[string]interface{}
+	if err := yaml.Unmarshal(yamlData, &fromYAML); err != nil {
+		t.Fatalf("invalid YAML: %v\n%s", err, yamlData)
+	}
+	if got := normalize(t, fromYAML); !reflect.DeepEqual(got, want) {
+		t.Errorf("YAML does not round-trip\ngot:  %v\nwant: %v", got, want)
+	}
+
+	tomlData, err := excelmetadata.MarshalTOML(metadata)
+	if err != nil {
+		t.Fatalf("MarshalTOML() error = %v", err)
+	}
+	var fromTOML map[string]interface{}
+	if err := toml.Unmarshal(tomlData, &fromTOML); err != nil {
+		t.Fatalf("invalid TOML: %v\n%s", err, tomlData)
+	}
+	if got := normalize(t, fromTOML); !reflect.DeepEqual(got, want) {
+		t.Errorf("TOML does not round-trip\ngot:  %v\nwant: %v", got, want)
+	}
+
+	// Field names and order follow the JSON output
+	if !strings.HasPrefix(string(yamlData), "filename:") || !strings.HasPrefix(string(tomlData), "filename =") {
+		t.Errorf("unexpected leading field:\n%s\n%s", yamlData, tomlData)
+	}
+}
+
+func TestMarshalTOMLNullInArray(t *testing.T) {
+	metadata := &excelmetadata.Metadata{Sheets: []excelmetadata.SheetMetadata{{
+		Name:  "Sheet1",
+		Cells: []excelmetadata.CellMetadata{{Address: "A1", Value: []interface{}{"a", nil, "b"}}},
+	}}}
+	_, err := excelmetadata.MarshalTOML(metadata)
+	if err == nil || !strings.Contains(err.Error(), "sheets.cells.value") || !strings.Contains(err.Error(), "index 1") {
+		t.Errorf("MarshalTOML() error = %v, want the null at sheets.cells.value index 1", err)
+	}
+}
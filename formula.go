@@ -0,0 +1,126 @@
+package excelmetadata
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strconv"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Formula kinds
+const (
+	FormulaNormal    = "normal"
+	FormulaShared    = "shared"
+	FormulaArray     = "array"
+	FormulaDynamic   = "dynamic"
+	FormulaDataTable = "dataTable"
+)
+
+// FormulaInfo describes how a formula is stored in the workbook
+type FormulaInfo struct {
+	// Kind is FormulaNormal, FormulaShared, FormulaArray (a legacy CSE
+	// array formula), FormulaDynamic (a dynamic array formula that spills)
+	// or FormulaDataTable
+	Kind string `json:"kind"`
+	// Ref is the range of a shared, array or data table formula. Shared
+	// formulas only store it on the cell holding the formula text.
+	Ref string `json:"ref,omitempty"`
+	// SharedIndex groups the cells of a shared formula
+	SharedIndex *int `json:"sharedIndex,omitempty"`
+	// SpillRange is the range a dynamic array formula spills into
+	SpillRange string `json:"spillRange,omitempty"`
+	// CachedValue is the result Excel stored when it last calculated the
+	// formula, and CachedType its cell type (n, str, b or e)
+	CachedValue string `json:"cachedValue,omitempty"`
+	CachedType  string `json:"cachedType,omitempty"`
+}
+
+// xmlFormulaCell is a cell element of a worksheet part
+type xmlFormulaCell struct {
+	R  string `xml:"r,attr"`
+	T  string `xml:"t,attr"`
+	CM string `xml:"cm,attr"`
+	F  *struct {
+		T   string `xml:"t,attr"`
+		Ref string `xml:"ref,attr"`
+		SI  string `xml:"si,attr"`
+	} `xml:"f"`
+	V *string `xml:"v"`
+}
+
+// scanFormulas returns the formula details of every formula cell of a
+// worksheet part, keyed by cell address. An array formula whose cell
+// carries cell metadata (cm) is a dynamic array formula.
+func scanFormulas(content []byte) map[string]*FormulaInfo {
+	formulas := make(map[string]*FormulaInfo)
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	row, col := 0, 0
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			break
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch start.Name.Local {
+		case "row":
+			row++
+			col = 0
+			if r, err := strconv.Atoi(attrValue(start, "r")); err == nil {
+				row = r
+			}
+		case "c":
+			col++
+			var cell xmlFormulaCell
+			if err := decoder.DecodeElement(&cell, &start); err != nil {
+				return formulas
+			}
+			if c, r, err := excelize.CellNameToCoordinates(cell.R); err == nil {
+				col, row = c, r
+			}
+			if cell.F == nil {
+				continue
+			}
+
+			info := &FormulaInfo{Kind: FormulaNormal, Ref: cell.F.Ref}
+			switch cell.F.T {
+			case "shared":
+				info.Kind = FormulaShared
+			case "array":
+				info.Kind = FormulaArray
+				if cell.CM != "" {
+					info.Kind = FormulaDynamic
+					info.SpillRange = cell.F.Ref
+				}
+			case "dataTable":
+				info.Kind = FormulaDataTable
+			}
+			if si, err := strconv.Atoi(cell.F.SI); err == nil {
+				info.SharedIndex = &si
+			}
+			if cell.V != nil {
+				info.CachedValue = *cell.V
+				info.CachedType = cell.T
+				if info.CachedType == "" {
+					info.CachedType = "n"
+				}
+			}
+
+			address, _ := excelize.CoordinatesToCellName(col, row)
+			formulas[address] = info
+		}
+	}
+	return formulas
+}
+
+// sheetFormulas returns the formula details of a sheet
+func (e *Extractor) sheetFormulas(sheetName string) map[string]*FormulaInfo {
+	part, ok := e.sheetParts()[sheetName]
+	if !ok {
+		return nil
+	}
+	return scanFormulas(e.readPart(part))
+}
@@ -0,0 +1,455 @@
+package excelmetadata
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/xuri/efp"
+)
+
+// Formula node kinds
+const (
+	NodeFunction  = "function"
+	NodeOperator  = "operator"
+	NodePrefix    = "prefix"
+	NodePostfix   = "postfix"
+	NodeReference = "reference"
+	NodeNumber    = "number"
+	NodeText      = "text"
+	NodeLogical   = "logical"
+	NodeError     = "error"
+	NodeArray     = "array"
+	NodeArrayRow  = "arrayRow"
+	NodeGroup     = "group"
+	NodeEmpty     = "empty"
+)
+
+// FormulaAST is the syntax tree of a formula
+type FormulaAST struct {
+	Root *FormulaNode `json:"root"`
+}
+
+// FormulaNode is a node of a formula syntax tree. Value holds the function
+// name, the operator or the literal; operators have two children, prefix and
+// postfix operators and groups (parentheses) one, functions one per
+// argument, arrays one per row and rows one per element. Omitted function
+// arguments are NodeEmpty.
+type FormulaNode struct {
+	Kind     string         `json:"kind"`
+	Value    string         `json:"value,omitempty"`
+	Children []*FormulaNode `json:"children,omitempty"`
+}
+
+// ParseFormula tokenizes a formula with efp and builds its syntax tree. The
+// leading "=" is optional. Structured references such as
+// Table1[[#This Row],[Col]] are single references, and the spill operator of
+// A1# is a NodePostfix.
+func ParseFormula(formula string) (*FormulaAST, error) {
+	ps := efp.ExcelParser()
+	tokens := unmaskTokens(ps.Parse(maskFormula(formula)))
+	switch {
+	case ps.InString || ps.InPath || ps.InRange || ps.InError:
+		return nil, fmt.Errorf("invalid formula %q: unterminated literal", formula)
+	case len(ps.TokenStack.Items) > 0:
+		return nil, fmt.Errorf("invalid formula %q: missing closing parenthesis", formula)
+	case len(tokens) == 0:
+		return nil, fmt.Errorf("invalid formula %q: empty formula", formula)
+	}
+
+	p := &formulaParser{tokens: tokens}
+	root, err := p.expression(0)
+	if err == nil && p.pos < len(p.tokens) {
+		err = fmt.Errorf("unexpected %s", p.describe(p.tokens[p.pos]))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid formula %q: %w", formula, err)
+	}
+	return &FormulaAST{Root: root}, nil
+}
+
+// Characters standing in for what efp cannot tokenize: commas inside the
+// brackets of structured references, which efp takes for argument
+// separators, and the spill operator, which it takes for an error literal
+const (
+	maskedComma = '\x02'
+	maskedSpill = '\x01'
+)
+
+// maskFormula hides structured reference commas and spill operators from efp
+func maskFormula(formula string) string {
+	var (
+		sb      strings.Builder
+		depth   int
+		inText  bool
+		inQuote bool
+		prev    rune
+	)
+	for _, r := range formula {
+		switch {
+		case inText:
+			inText = r != '"'
+		case inQuote:
+			inQuote = r != '\''
+		case r == '"' && depth == 0:
+			inText = true
+		case r == '\'' && depth == 0:
+			inQuote = true
+		case r == '[':
+			depth++
+		case r == ']' && depth > 0:
+			depth--
+		case r == ',' && depth > 0:
+			r = maskedComma
+		// An error literal such as #N/A never follows a reference
+		case r == '#' && depth == 0 && (unicode.IsLetter(prev) || unicode.IsDigit(prev) || prev == '$' || prev == ']'):
+			r = maskedSpill
+		}
+		sb.WriteRune(r)
+		prev = r
+	}
+	return sb.String()
+}
+
+// unmaskTokens restores masked commas and turns masked spill operators into
+// postfix operator tokens
+func unmaskTokens(tokens []efp.Token) []efp.Token {
+	result := make([]efp.Token, 0, len(tokens))
+	for _, token := range tokens {
+		token.TValue = strings.ReplaceAll(token.TValue, string(maskedComma), ",")
+		if token.TType == efp.TokenTypeOperand && strings.HasSuffix(token.TValue, string(maskedSpill)) {
+			token.TValue = strings.TrimSuffix(token.TValue, string(maskedSpill))
+			result = append(result, token, efp.Token{TValue: "#", TType: efp.TokenTypeOperatorPostfix})
+			continue
+		}
+		result = append(result, token)
+	}
+	return result
+}
+
+// String renders the formula text, without the leading "="
+func (a *FormulaAST) String() string {
+	var sb strings.Builder
+	if a != nil && a.Root != nil {
+		a.Root.render(&sb)
+	}
+	return sb.String()
+}
+
+// Walk calls fn for every node in depth-first order, skipping the children
+// of nodes for which fn returns false
+func (a *FormulaAST) Walk(fn func(*FormulaNode) bool) {
+	var walk func(*FormulaNode)
+	walk = func(n *FormulaNode) {
+		if n == nil || !fn(n) {
+			return
+		}
+		for _, child := range n.Children {
+			walk(child)
+		}
+	}
+	if a != nil {
+		walk(a.Root)
+	}
+}
+
+// Functions returns the names of the functions used, in order of appearance
+func (a *FormulaAST) Functions() []string {
+	return a.collect(func(n *FormulaNode) string {
+		if n.Kind == NodeFunction {
+			return strings.ToUpper(n.Value)
+		}
+		return ""
+	})
+}
+
+// References returns the cell references, ranges and names used, in order of
+// appearance
+func (a *FormulaAST) References() []string {
+	return a.collect(func(n *FormulaNode) string {
+		if n.Kind == NodeReference {
+			return n.String()
+		}
+		return ""
+	})
+}
+
+// Constants returns the number, text, logical and error literals, in order of
+// appearance
+func (a *FormulaAST) Constants() []string {
+	return a.collect(func(n *FormulaNode) string {
+		switch n.Kind {
+		case NodeNumber, NodeText, NodeLogical, NodeError:
+			return n.String()
+		}
+		return ""
+	})
+}
+
+// collect returns the distinct non-empty results of fn over every node
+func (a *FormulaAST) collect(fn func(*FormulaNode) string) []string {
+	var values []string
+	seen := make(map[string]bool)
+	a.Walk(func(n *FormulaNode) bool {
+		if v := fn(n); v != "" && !seen[v] {
+			seen[v] = true
+			values = append(values, v)
+		}
+		return true
+	})
+	return values
+}
+
+// String renders the node as formula text
+func (n *FormulaNode) String() string {
+	var sb strings.Builder
+	n.render(&sb)
+	return sb.String()
+}
+
+func (n *FormulaNode) render(sb *strings.Builder) {
+	join := func(children []*FormulaNode, sep string) {
+		for i, child := range children {
+			if i > 0 {
+				sb.WriteString(sep)
+			}
+			child.render(sb)
+		}
+	}
+
+	switch n.Kind {
+	case NodeFunction:
+		sb.WriteString(n.Value)
+		sb.WriteByte('(')
+		join(n.Children, ",")
+		sb.WriteByte(')')
+	case NodeArray:
+		sb.WriteByte('{')
+		join(n.Children, ";")
+		sb.WriteByte('}')
+	case NodeArrayRow:
+		join(n.Children, ",")
+	case NodeGroup:
+		sb.WriteByte('(')
+		join(n.Children, "")
+		sb.WriteByte(')')
+	case NodeOperator:
+		join(n.Children, n.Value)
+	case NodePrefix:
+		sb.WriteString(n.Value)
+		join(n.Children, "")
+	case NodePostfix:
+		join(n.Children, "")
+		sb.WriteString(n.Value)
+	case NodeText:
+		sb.WriteByte('"')
+		sb.WriteString(strings.ReplaceAll(n.Value, `"`, `""`))
+		sb.WriteByte('"')
+	case NodeReference:
+		sb.WriteString(quoteReference(n.Value))
+	default:
+		sb.WriteString(n.Value)
+	}
+}
+
+// quoteReference restores the quotes efp strips from sheet names such as
+// 'Q1 Data'!A1
+func quoteReference(ref string) string {
+	i := strings.LastIndex(ref, "!")
+	if i <= 0 {
+		return ref
+	}
+	sheet := ref[:i]
+	quote := unicode.IsDigit([]rune(sheet)[0])
+	for _, r := range sheet {
+		quote = quote || !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.')
+	}
+	if !quote {
+		return ref
+	}
+	return "'" + strings.ReplaceAll(sheet, "'", "''") + "'" + ref[i:]
+}
+
+// binaryPrecedence of the infix operators, from loosest to tightest
+func binaryPrecedence(token efp.Token) int {
+	switch {
+	case token.TSubType == efp.TokenSubTypeLogical:
+		return 1
+	case token.TSubType == efp.TokenSubTypeConcatenation:
+		return 2
+	case token.TValue == "+" || token.TValue == "-":
+		return 3
+	case token.TValue == "*" || token.TValue == "/":
+		return 4
+	case token.TValue == "^":
+		return 5
+	case token.TSubType == efp.TokenSubTypeUnion:
+		return 6
+	case token.TSubType == efp.TokenSubTypeIntersection:
+		return 7
+	}
+	return 0
+}
+
+// formulaParser builds a syntax tree from efp tokens by precedence climbing
+type formulaParser struct {
+	tokens []efp.Token
+	pos    int
+}
+
+func (p *formulaParser) peek() *efp.Token {
+	if p.pos < len(p.tokens) {
+		return &p.tokens[p.pos]
+	}
+	return nil
+}
+
+func (p *formulaParser) describe(token efp.Token) string {
+	if token.TSubType == efp.TokenSubTypeStop {
+		return `")"`
+	}
+	return fmt.Sprintf("%q", token.TValue)
+}
+
+func (p *formulaParser) expression(minPrecedence int) (*FormulaNode, error) {
+	left, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		token := p.peek()
+		if token == nil || token.TType != efp.TokenTypeOperatorInfix {
+			return left, nil
+		}
+		precedence := binaryPrecedence(*token)
+		if precedence < minPrecedence {
+			return left, nil
+		}
+		op := token.TValue
+		if token.TSubType == efp.TokenSubTypeIntersection {
+			op = " "
+		}
+		p.pos++
+		right, err := p.expression(precedence + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = &FormulaNode{Kind: NodeOperator, Value: op, Children: []*FormulaNode{left, right}}
+	}
+}
+
+func (p *formulaParser) unary() (*FormulaNode, error) {
+	var node *FormulaNode
+	if token := p.peek(); token != nil && token.TType == efp.TokenTypeOperatorPrefix {
+		p.pos++
+		operand, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		node = &FormulaNode{Kind: NodePrefix, Value: token.TValue, Children: []*FormulaNode{operand}}
+	} else {
+		var err error
+		if node, err = p.primary(); err != nil {
+			return nil, err
+		}
+	}
+	for token := p.peek(); token != nil && token.TType == efp.TokenTypeOperatorPostfix; token = p.peek() {
+		p.pos++
+		node = &FormulaNode{Kind: NodePostfix, Value: token.TValue, Children: []*FormulaNode{node}}
+	}
+	return node, nil
+}
+
+func (p *formulaParser) primary() (*FormulaNode, error) {
+	token := p.peek()
+	if token == nil {
+		return nil, fmt.Errorf("unexpected end of formula")
+	}
+
+	switch {
+	case token.TType == efp.TokenTypeOperand:
+		p.pos++
+		kind := NodeReference
+		switch token.TSubType {
+		case efp.TokenSubTypeNumber:
+			kind = NodeNumber
+		case efp.TokenSubTypeText:
+			kind = NodeText
+		case efp.TokenSubTypeLogical:
+			kind = NodeLogical
+		case efp.TokenSubTypeError:
+			kind = NodeError
+		}
+		return &FormulaNode{Kind: kind, Value: token.TValue}, nil
+
+	case token.TType == efp.TokenTypeFunction && token.TSubType == efp.TokenSubTypeStart:
+		p.pos++
+		node := &FormulaNode{Kind: NodeFunction, Value: token.TValue}
+		args, err := p.arguments()
+		if err != nil {
+			return nil, err
+		}
+		node.Children = args
+		// efp reads {1,2;3,4} as ARRAY(ARRAYROW(1,2),ARRAYROW(3,4))
+		if node.Value == "ARRAY" {
+			node.Kind, node.Value = NodeArray, ""
+			for _, row := range node.Children {
+				row.Kind, row.Value = NodeArrayRow, ""
+			}
+		}
+		return node, nil
+
+	case token.TType == efp.TokenTypeSubexpression && token.TSubType == efp.TokenSubTypeStart:
+		p.pos++
+		inner, err := p.expression(0)
+		if err != nil {
+			return nil, err
+		}
+		if stop := p.peek(); stop == nil || stop.TType != efp.TokenTypeSubexpression || stop.TSubType != efp.TokenSubTypeStop {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		p.pos++
+		return &FormulaNode{Kind: NodeGroup, Children: []*FormulaNode{inner}}, nil
+	}
+
+	return nil, fmt.Errorf("unexpected %s", p.describe(*token))
+}
+
+// arguments parses the comma separated arguments of a function up to and
+// including its closing parenthesis
+func (p *formulaParser) arguments() ([]*FormulaNode, error) {
+	isStop := func(token *efp.Token) bool {
+		return token != nil && token.TType == efp.TokenTypeFunction && token.TSubType == efp.TokenSubTypeStop
+	}
+	if isStop(p.peek()) {
+		p.pos++
+		return nil, nil
+	}
+
+	var args []*FormulaNode
+	for {
+		token := p.peek()
+		if token != nil && (token.TType == efp.TokenTypeArgument || isStop(token)) {
+			args = append(args, &FormulaNode{Kind: NodeEmpty})
+		} else {
+			arg, err := p.expression(0)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+		}
+
+		token = p.peek()
+		switch {
+		case token == nil:
+			return nil, fmt.Errorf("missing closing parenthesis")
+		case token.TType == efp.TokenTypeArgument:
+			p.pos++
+		case isStop(token):
+			p.pos++
+			return args, nil
+		default:
+			return nil, fmt.Errorf("unexpected %s", p.describe(*token))
+		}
+	}
+}
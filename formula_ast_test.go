@@ -0,0 +1,153 @@
+package excelmetadata_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/prongbang/excelmetadata"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestParseFormula(t *testing.T) {
+	tests := []struct {
+		formula    string
+		text       string
+		functions  []string
+		references []string
+		constants  []string
+	}{
+		{
+			formula:    "=SUM(A1:A10)*2",
+			text:       "SUM(A1:A10)*2",
+			functions:  []string{"SUM"},
+			references: []string{"A1:A10"},
+			constants:  []string{"2"},
+		},
+		{
+			formula:    `IF(AND(B2>0, 'Q1 Data'!C3<>""), VLOOKUP(B2,Rates,2,FALSE), "n/a")`,
+			text:       `IF(AND(B2>0,'Q1 Data'!C3<>""),VLOOKUP(B2,Rates,2,FALSE),"n/a")`,
+			functions:  []string{"IF", "AND", "VLOOKUP"},
+			references: []string{"B2", "'Q1 Data'!C3", "Rates"},
+			constants:  []string{"0", `""`, "2", "FALSE", `"n/a"`},
+		},
+		{
+			formula:   `-(1+2)^2%&"say ""hi"""`,
+			text:      `-(1+2)^2%&"say ""hi"""`,
+			constants: []string{"1", "2", `"say ""hi"""`},
+		},
+		{
+			formula:    "SUMPRODUCT({1,2;3,4},Sheet2!$A$1:$B$2)",
+			text:       "SUMPRODUCT({1,2;3,4},Sheet2!$A$1:$B$2)",
+			functions:  []string{"SUMPRODUCT"},
+			references: []string{"Sheet2!$A$1:$B$2"},
+			constants:  []string{"1", "2", "3", "4"},
+		},
+		{
+			formula:    "IFERROR(A1/B1,,#N/A)",
+			text:       "IFERROR(A1/B1,,#N/A)",
+			functions:  []string{"IFERROR"},
+			references: []string{"A1", "B1"},
+			constants:  []string{"#N/A"},
+		},
+		{
+			formula:    "SUM(Table1[[#This Row],[Col]],Table1[[#Headers],[A]:[B]])*Table1[@Qty]",
+			text:       "SUM(Table1[[#This Row],[Col]],Table1[[#Headers],[A]:[B]])*Table1[@Qty]",
+			functions:  []string{"SUM"},
+			references: []string{"Table1[[#This Row],[Col]]", "Table1[[#Headers],[A]:[B]]", "Table1[@Qty]"},
+		},
+		{
+			formula:    "=SUM(A1#)+'Q1 Data'!$B$2#*IF(C1,#N/A)",
+			text:       "SUM(A1#)+'Q1 Data'!$B$2#*IF(C1,#N/A)",
+			functions:  []string{"SUM", "IF"},
+			references: []string{"A1", "'Q1 Data'!$B$2", "C1"},
+			constants:  []string{"#N/A"},
+		},
+		{
+			formula:    "SUM((A1:A3,C1:C3))+NOW()",
+			text:       "SUM((A1:A3,C1:C3))+NOW()",
+			functions:  []string{"SUM", "NOW"},
+			references: []string{"A1:A3", "C1:C3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.formula, func(t *testing.T) {
+			ast, err := excelmetadata.ParseFormula(tt.formula)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := ast.String(); got != tt.text {
+				t.Errorf("String() = %s, want %s", got, tt.text)
+			}
+			if got := ast.Functions(); !reflect.DeepEqual(got, tt.functions) {
+				t.Errorf("Functions() = %q, want %q", got, tt.functions)
+			}
+			if got := ast.References(); !reflect.DeepEqual(got, tt.references) {
+				t.Errorf("References() = %q, want %q", got, tt.references)
+			}
+			if got := ast.Constants(); !reflect.DeepEqual(got, tt.constants) {
+				t.Errorf("Constants() = %q, want %q", got, tt.constants)
+			}
+
+			again, err := excelmetadata.ParseFormula(ast.String())
+			if err != nil {
+				t.Fatalf("rendered formula does not parse: %v", err)
+			}
+			if !reflect.DeepEqual(again, ast) {
+				t.Errorf("round trip changed the tree of %s", ast)
+			}
+		})
+	}
+}
+
+func TestParseFormulaPrecedence(t *testing.T) {
+	ast, err := excelmetadata.ParseFormula("1+2*3^2&A1=B1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// ((1+(2*(3^2)))&A1)=B1
+	root := ast.Root
+	if root.Kind != excelmetadata.NodeOperator || root.Value != "=" {
+		t.Fatalf("root = %+v, want =", root)
+	}
+	concat := root.Children[0]
+	if concat.Value != "&" {
+		t.Fatalf("left of = is %q, want &", concat.Value)
+	}
+	sum := concat.Children[0]
+	if sum.Value != "+" || sum.Children[1].Value != "*" || sum.Children[1].Children[1].Value != "^" {
+		t.Errorf("sum = %s, want 1+(2*(3^2))", sum)
+	}
+}
+
+func TestParseFormulaErrors(t *testing.T) {
+	for _, formula := range []string{
+		"",
+		"=",
+		"SUM(A1",
+		"SUM(A1))",
+		`"open`,
+		"1+",
+		"(1",
+	} {
+		if ast, err := excelmetadata.ParseFormula(formula); err == nil {
+			t.Errorf("ParseFormula(%q) = %s, want error", formula, ast)
+		}
+	}
+}
+
+func TestExtractParsedFormulas(t *testing.T) {
+	filename := newWorkbook(t, func(f *excelize.File) {
+		_ = f.SetCellValue("Sheet1", "A1", 1)
+		_ = f.SetCellValue("Sheet1", "B1", 2)
+		_ = f.SetCellFormula("Sheet1", "B1", "ROUND(SUM(A1,A1),0)")
+	})
+	metadata := extractWithOptions(t, filename, func(o *excelmetadata.Options) { o.ParseFormulas = true })
+
+	cell := metadata.Sheets[0].Cells[1]
+	if !reflect.DeepEqual(cell.Functions, []string{"ROUND", "SUM"}) || !reflect.DeepEqual(cell.References, []string{"A1"}) {
+		t.Errorf("B1 functions = %q, references = %q", cell.Functions, cell.References)
+	}
+	if value := metadata.Sheets[0].Cells[0]; value.Functions != nil || value.References != nil {
+		t.Errorf("A1 without formula has functions %q and references %q", value.Functions, value.References)
+	}
+}
@@ -0,0 +1,79 @@
+package excelmetadata_test
+
+import (
+	"testing"
+
+	"github.com/prongbang/excelmetadata"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestFormulaInfo(t *testing.T) {
+	filename := newWorkbook(t, func(f *excelize.File) {
+		for row, value := range []int{1, 2, 3} {
+			cell, _ := excelize.CoordinatesToCellName(1, row+1)
+			_ = f.SetCellValue("Sheet1", cell, value)
+		}
+
+		_ = f.SetCellValue("Sheet1", "B1", 2)
+		_ = f.SetCellValue("Sheet1", "B2", 4)
+		_ = f.SetCellValue("Sheet1", "B3", 6)
+		shared, sharedRef := excelize.STCellFormulaTypeShared, "B1:B3"
+		_ = f.SetCellFormula("Sheet1", "B1", "A1*2", excelize.FormulaOpts{Type: &shared, Ref: &sharedRef})
+
+		_ = f.SetCellValue("Sheet1", "C1", 6)
+		array, arrayRef := excelize.STCellFormulaTypeArray, "C1"
+		_ = f.SetCellFormula("Sheet1", "C1", "SUM(A1:A3*1)", excelize.FormulaOpts{Type: &array, Ref: &arrayRef})
+
+		_ = f.SetCellValue("Sheet1", "D1", 1)
+		spillRef := "D1:D3"
+		_ = f.SetCellFormula("Sheet1", "D1", "SEQUENCE(3)", excelize.FormulaOpts{Type: &array, Ref: &spillRef})
+
+		_ = f.SetCellValue("Sheet1", "E1", true)
+		_ = f.SetCellFormula("Sheet1", "E1", "A1>0")
+	})
+	// Excel marks dynamic array formulas with cell metadata
+	rewritePart(t, filename, "xl/worksheets/sheet1.xml", `<c r="D1"`, `<c r="D1" cm="1"`)
+
+	metadata := extract(t, filename)
+	cells := make(map[string]excelmetadata.CellMetadata)
+	for _, cell := range metadata.Sheets[0].Cells {
+		cells[cell.Address] = cell
+	}
+
+	// SetCellFormula marks the cached results of the formulas it writes as
+	// strings, the other cells of the shared formula keep their number type
+	zero := 0
+	tests := []struct {
+		cell    string
+		formula string
+		want    *excelmetadata.FormulaInfo
+	}{
+		{"A1", "", nil},
+		{"B1", "A1*2", &excelmetadata.FormulaInfo{Kind: excelmetadata.FormulaShared, Ref: "B1:B3", SharedIndex: &zero, CachedValue: "2", CachedType: "str"}},
+		{"B3", "A3*2", &excelmetadata.FormulaInfo{Kind: excelmetadata.FormulaShared, SharedIndex: &zero, CachedValue: "6", CachedType: "n"}},
+		{"C1", "SUM(A1:A3*1)", &excelmetadata.FormulaInfo{Kind: excelmetadata.FormulaArray, Ref: "C1", CachedValue: "6", CachedType: "str"}},
+		{"D1", "SEQUENCE(3)", &excelmetadata.FormulaInfo{Kind: excelmetadata.FormulaDynamic, Ref: "D1:D3", SpillRange: "D1:D3", CachedValue: "1", CachedType: "str"}},
+		{"E1", "A1>0", &excelmetadata.FormulaInfo{Kind: excelmetadata.FormulaNormal, CachedValue: "1", CachedType: "str"}},
+	}
+	for _, tt := range tests {
+		cell, ok := cells[tt.cell]
+		if !ok {
+			t.Errorf("%s not extracted", tt.cell)
+			continue
+		}
+		if cell.Formula != tt.formula {
+			t.Errorf("%s formula = %q, want %q", tt.cell, cell.Formula, tt.formula)
+		}
+		if got := cell.FormulaInfo; (got == nil) != (tt.want == nil) || got != nil && !formulaInfoEqual(*got, *tt.want) {
+			t.Errorf("%s formula info = %+v, want %+v", tt.cell, got, tt.want)
+		}
+	}
+}
+
+func formulaInfoEqual(a, b excelmetadata.FormulaInfo) bool {
+	if (a.SharedIndex == nil) != (b.SharedIndex == nil) || a.SharedIndex != nil && *a.SharedIndex != *b.SharedIndex {
+		return false
+	}
+	a.SharedIndex, b.SharedIndex = nil, nil
+	return a == b
+}
@@ -0,0 +1,337 @@
+package excelmetadata
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	defaultGoPackage  = "main"
+	defaultGoVariable = "metadata"
+
+	// ptrHelper is the generic helper emitted for pointers to scalar values
+	ptrHelper = "excelmetadataPtr"
+)
+
+var (
+	packagePath  = reflect.TypeOf(Metadata{}).PkgPath()
+	excelizePath = reflect.TypeOf(excelize.CellType(0)).PkgPath()
+	timeType     = reflect.TypeOf(time.Time{})
+	cellTypeType = reflect.TypeOf(excelize.CellType(0))
+)
+
+var cellTypeNames = map[excelize.CellType]string{
+	excelize.CellTypeUnset:        "CellTypeUnset",
+	excelize.CellTypeBool:         "CellTypeBool",
+	excelize.CellTypeDate:         "CellTypeDate",
+	excelize.CellTypeError:        "CellTypeError",
+	excelize.CellTypeFormula:      "CellTypeFormula",
+	excelize.CellTypeInlineString: "CellTypeInlineString",
+	excelize.CellTypeNumber:       "CellTypeNumber",
+	excelize.CellTypeSharedString: "CellTypeSharedString",
+}
+
+// MarshalGo renders metadata as gofmt-formatted Go source declaring a
+// variable that holds the metadata. The source is generated by reflection,
+// so it covers every field of the metadata types. When the package is
+// "main", a main function recreating the workbook with excelrecreator is
+// emitted as well.
+func MarshalGo(metadata *Metadata, packageName, variableName string) ([]byte, error) {
+	if metadata == nil {
+		return nil, fmt.Errorf("metadata is nil")
+	}
+	if packageName == "" {
+		packageName = defaultGoPackage
+	}
+	if variableName == "" {
+		variableName = defaultGoVariable
+	}
+	if !token.IsIdentifier(packageName) {
+		return nil, fmt.Errorf("invalid package name %q", packageName)
+	}
+	if !token.IsIdentifier(variableName) {
+		return nil, fmt.Errorf("invalid variable name %q", variableName)
+	}
+
+	g := &goGenerator{imports: map[string]bool{packagePath: true}}
+	value := g.value(reflect.ValueOf(metadata))
+
+	isMain := packageName == "main"
+	if isMain {
+		g.imports[excelizePath] = true
+		g.imports["github.com/prongbang/excelrecreator"] = true
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by excelmetadata. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", packageName)
+
+	// Standard library imports first, then third-party ones
+	var std, thirdParty []string
+	for imp := range g.imports {
+		if strings.Contains(strings.Split(imp, "/")[0], ".") {
+			thirdParty = append(thirdParty, imp)
+		} else {
+			std = append(std, imp)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(thirdParty)
+	buf.WriteString("import (\n")
+	for _, imp := range std {
+		fmt.Fprintf(&buf, "%q\n", imp)
+	}
+	if len(std) > 0 {
+		buf.WriteString("\n")
+	}
+	for _, imp := range thirdParty {
+		fmt.Fprintf(&buf, "%q\n", imp)
+	}
+	buf.WriteString(")\n\n")
+
+	fmt.Fprintf(&buf, "var %s = %s\n", variableName, value)
+
+	if g.usesPtr {
+		fmt.Fprintf(&buf, "\nfunc %s[T any](v T) *T {\nreturn &v\n}\n", ptrHelper)
+	}
+
+	if isMain {
+		fmt.Fprintf(&buf, `
+func main() {
+f := excelize.NewFile()
+
+reCreator := &excelrecreator.Recreator{
+File:     f,
+Metadata: %s,
+Options:  excelrecreator.DefaultOptions(),
+StyleMap: make(map[int]int),
+}
+_ = reCreator.Recreate()
+
+_ = f.SaveAs("sample.clone.xlsx")
+}
+`, variableName)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format Go source: %w", err)
+	}
+	return src, nil
+}
+
+type goGenerator struct {
+	imports map[string]bool
+	usesPtr bool
+}
+
+// typeName returns the Go spelling of t and records the imports it needs
+func (g *goGenerator) typeName(t reflect.Type) string {
+	if t.Name() != "" {
+		switch t.PkgPath() {
+		case "":
+			return t.Name()
+		case packagePath:
+			return "excelmetadata." + t.Name()
+		case excelizePath:
+			g.imports[excelizePath] = true
+			return "excelize." + t.Name()
+		case "time":
+			g.imports["time"] = true
+			return "time." + t.Name()
+		default:
+			g.imports[t.PkgPath()] = true
+			return t.String()
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return "*" + g.typeName(t.Elem())
+	case reflect.Slice:
+		return "[]" + g.typeName(t.Elem())
+	case reflect.Map:
+		return "map[" + g.typeName(t.Key()) + "]" + g.typeName(t.Elem())
+	case reflect.Interface:
+		return "interface{}"
+	default:
+		return t.String()
+	}
+}
+
+// value renders v as a Go expression assignable to v's static type
+func (g *goGenerator) value(v reflect.Value) string {
+	t := v.Type()
+
+	switch {
+	case t == timeType:
+		tm := v.Interface().(time.Time).UTC()
+		g.imports["time"] = true
+		return fmt.Sprintf("time.Date(%d, time.%s, %d, %d, %d, %d, %d, time.UTC)",
+			tm.Year(), tm.Month(), tm.Day(), tm.Hour(), tm.Minute(), tm.Second(), tm.Nanosecond())
+	case t == cellTypeType:
+		g.imports[excelizePath] = true
+		if name, ok := cellTypeNames[excelize.CellType(v.Uint())]; ok {
+			return "excelize." + name
+		}
+		return fmt.Sprintf("excelize.CellType(%d)", v.Uint())
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return "nil"
+		}
+		if t.Elem().Kind() == reflect.Struct && t.Elem() != timeType {
+			return "&" + g.value(v.Elem())
+		}
+		g.usesPtr = true
+		return fmt.Sprintf("%s[%s](%s)", ptrHelper, g.typeName(t.Elem()), g.value(v.Elem()))
+	case reflect.Interface:
+		if v.IsNil() {
+			return "nil"
+		}
+		return g.dynamicValue(v.Elem())
+	case reflect.Struct:
+		return g.structValue(v)
+	case reflect.Slice:
+		if v.IsNil() {
+			return "nil"
+		}
+		if t.Elem().Kind() == reflect.Uint8 {
+			name := g.typeName(t)
+			if t.Name() == "" {
+				name = "[]byte"
+			}
+			return fmt.Sprintf("%s(%s)", name, strconv.Quote(string(v.Bytes())))
+		}
+		var buf bytes.Buffer
+		buf.WriteString(g.typeName(t) + "{\n")
+		for i := 0; i < v.Len(); i++ {
+			buf.WriteString(g.elemValue(v.Index(i)) + ",\n")
+		}
+		buf.WriteString("}")
+		return buf.String()
+	case reflect.Map:
+		if v.IsNil() {
+			return "nil"
+		}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return lessMapKey(keys[i], keys[j]) })
+		var buf bytes.Buffer
+		buf.WriteString(g.typeName(t) + "{\n")
+		for _, k := range keys {
+			buf.WriteString(g.value(k) + ": " + g.elemValue(v.MapIndex(k)) + ",\n")
+		}
+		buf.WriteString("}")
+		return buf.String()
+	default:
+		return g.scalar(v)
+	}
+}
+
+// elemValue renders a slice or map element, eliding the type of composite literals
+func (g *goGenerator) elemValue(v reflect.Value) string {
+	if v.Kind() == reflect.Struct && v.Type() != timeType {
+		return g.structFields(v)
+	}
+	return g.value(v)
+}
+
+func (g *goGenerator) structValue(v reflect.Value) string {
+	return g.typeName(v.Type()) + g.structFields(v)
+}
+
+// structFields renders "{...}" with every non-zero exported field
+func (g *goGenerator) structFields(v reflect.Value) string {
+	t := v.Type()
+	var buf bytes.Buffer
+	buf.WriteString("{\n")
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() || v.Field(i).IsZero() {
+			continue
+		}
+		buf.WriteString(field.Name + ": " + g.value(v.Field(i)) + ",\n")
+	}
+	buf.WriteString("}")
+	return buf.String()
+}
+
+// dynamicValue renders the concrete value stored in an interface{} field so
+// that it keeps its dynamic type
+func (g *goGenerator) dynamicValue(v reflect.Value) string {
+	t := v.Type()
+	if t == timeType || t == cellTypeType {
+		return g.value(v)
+	}
+
+	switch v.Kind() {
+	case reflect.String, reflect.Bool, reflect.Int:
+		if t.PkgPath() == "" {
+			// Untyped constants of these kinds default to the right type
+			return g.scalar(v)
+		}
+		return fmt.Sprintf("%s(%s)", g.typeName(t), g.scalar(v))
+	case reflect.Float32, reflect.Float64, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return fmt.Sprintf("%s(%s)", g.typeName(t), g.scalar(v))
+	default:
+		return g.value(v)
+	}
+}
+
+func (g *goGenerator) scalar(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		return strconv.Quote(v.String())
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		switch {
+		case math.IsNaN(f):
+			g.imports["math"] = true
+			return "math.NaN()"
+		case math.IsInf(f, 0):
+			g.imports["math"] = true
+			if f > 0 {
+				return "math.Inf(1)"
+			}
+			return "math.Inf(-1)"
+		}
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	default:
+		return fmt.Sprintf("%#v", v.Interface())
+	}
+}
+
+func lessMapKey(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.String:
+		if ca, err := excelize.ColumnNameToNumber(a.String()); err == nil {
+			if cb, err := excelize.ColumnNameToNumber(b.String()); err == nil {
+				return ca < cb
+			}
+		}
+		return a.String() < b.String()
+	default:
+		return fmt.Sprint(a.Interface()) < fmt.Sprint(b.Interface())
+	}
+}
@@ -0,0 +1,183 @@
+package excelmetadata_test
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/prongbang/excelmetadata"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestMarshalGoCompiles(t *testing.T) {
+	filename := newWorkbook(t, func(f *excelize.File) {
+		style, _ := f.NewStyle(&excelize.Style{
+			Font:       &excelize.Font{Bold: true, Color: "FF0000"},
+			Fill:       excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{"FFFF00"}},
+			Border:     []excelize.Border{{Type: "left", Color: "000000", Style: 1}},
+			Alignment:  &excelize.Alignment{Horizontal: "center", WrapText: true},
+			Protection: &excelize.Protection{Locked: true},
+		})
+		_ = f.SetCellValue("Sheet1", "A1", "Quote \" and \\ backslash")
+		_ = f.SetCellStyle("Sheet1", "A1", "A1", style)
+		_ = f.SetCellValue("Sheet1", "B1", 42.5)
+		_ = f.SetCellValue("Sheet1", "C1", true)
+		_ = f.SetCellHyperLink("Sheet1", "A1", "https://example.com", "External")
+		_ = f.MergeCell("Sheet1", "D1", "E2")
+		_ = f.SetColWidth("Sheet1", "A", "A", 30)
+		_ = f.SetRowHeight("Sheet1", 1, 24)
+		_ = f.SetDefinedName(&excelize.DefinedName{Name: "Total", RefersTo: "Sheet1!$B$1"})
+		_ = f.ProtectSheet("Sheet1", &excelize.SheetProtectionOptions{Password: "secret"})
+
+		dv := excelize.NewDataValidation(true)
+		dv.Sqref = "F1:F10"
+		_ = dv.SetRange(1, 10, excelize.DataValidationTypeWhole, excelize.DataValidationOperatorBetween)
+		dv.SetError(excelize.DataValidationErrorStyleStop, "Invalid", "Enter 1 to 10")
+		_ = f.AddDataValidation("Sheet1", dv)
+
+		img := image.NewRGBA(image.Rect(0, 0, 2, 2))
+		img.Set(0, 0, color.RGBA{R: 255, A: 255})
+		var buf bytes.Buffer
+		_ = png.Encode(&buf, img)
+		printObject := true
+		if err := f.AddPictureFromBytes("Sheet1", "G2", &excelize.Picture{
+			Extension: ".png",
+			File:      buf.Bytes(),
+			Format:    &excelize.GraphicOptions{AltText: "pixel", PrintObject: &printObject, ScaleX: 1, ScaleY: 1},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	src, err := excelmetadata.MarshalGo(extract(t, filename), "fixture", "Workbook")
+	if err != nil {
+		t.Fatalf("MarshalGo() error = %v", err)
+	}
+	if formatted, err := format.Source(src); err != nil || !bytes.Equal(formatted, src) {
+		t.Fatalf("generated source is not gofmt-formatted (err = %v)", err)
+	}
+
+	for _, want := range []string{"excelmetadataPtr[string](", "time.Date(", "[]byte(", "excelize.CellType"} {
+		if !bytes.Contains(src, []byte(want)) {
+			t.Errorf("generated source does not contain %q", want)
+		}
+	}
+
+	compileGo(t, src)
+
+	// A main package also recreates the workbook with excelrecreator
+	src, err = excelmetadata.MarshalGo(extract(t, filename), "", "")
+	if err != nil {
+		t.Fatalf("MarshalGo() error = %v", err)
+	}
+	if !bytes.Contains(src, []byte("func main()")) {
+		t.Error("generated main package has no main function")
+	}
+	compileGo(t, src)
+}
+
+func TestMarshalGoRejectsInvalidNames(t *testing.T) {
+	metadata := &excelmetadata.Metadata{}
+	if _, err := excelmetadata.MarshalGo(metadata, "my-package", ""); err == nil {
+		t.Error("expected an error for an invalid package name")
+	}
+	if _, err := excelmetadata.MarshalGo(metadata, "", "1var"); err == nil {
+		t.Error("expected an error for an invalid variable name")
+	}
+}
+
+// recreatorStub stands in for excelrecreator, which the main function of
+// generated code calls, with the API that code relies on
+const recreatorStub = `package excelrecreator
+
+import (
+	"github.com/prongbang/excelmetadata"
+	"github.com/xuri/excelize/v2"
+)
+
+type Options struct{}
+
+func DefaultOptions() *Options { return &Options{} }
+
+type Recreator struct {
+	File     *excelize.File
+	Metadata *excelmetadata.Metadata
+	Options  *Options
+	StyleMap map[int]int
+}
+
+func (r *Recreator) Recreate() error { return nil }
+`
+
+// compileGo type-checks generated source in a temporary module that
+// replaces this module and excelrecreator with local copies
+func compileGo(t *testing.T, src []byte) {
+	t.Helper()
+
+	if testing.Short() {
+		t.Skip("compiles generated code with the go tool")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go tool not found")
+	}
+
+	root, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	gomod, err := os.ReadFile(filepath.Join(root, "go.mod"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	gosum, err := os.ReadFile(filepath.Join(root, "go.sum"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Reuse this module's requirements so no module has to be downloaded
+	dir := t.TempDir()
+	stub := filepath.Join(dir, "excelrecreator")
+	gomod = bytes.Replace(gomod, []byte("module github.com/prongbang/excelmetadata"), []byte("module generated"), 1)
+	gomod = append(gomod, fmt.Sprintf(`
+require (
+	github.com/prongbang/excelmetadata v0.0.0
+	github.com/prongbang/excelrecreator v0.0.0
+)
+
+replace (
+	github.com/prongbang/excelmetadata => %q
+	github.com/prongbang/excelrecreator => %q
+)
+`, root, stub)...)
+	files := map[string][]byte{
+		"go.mod":                           gomod,
+		"go.sum":                           gosum,
+		"generated.go":                     src,
+		"excelrecreator/go.mod":            []byte("module github.com/prongbang/excelrecreator\n"),
+		"excelrecreator/excelrecreator.go": []byte(recreatorStub),
+	}
+	for name, data := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cmd := exec.Command(goTool, "vet", ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOWORK=off")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("generated source does not compile: %v\n%s\n%s", err, out, src)
+	}
+}
@@ -0,0 +1,144 @@
+package excelmetadata_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prongbang/excelmetadata"
+	"github.com/xuri/excelize/v2"
+)
+
+// newWorkbook writes a workbook built by setup to a temporary directory
+func newWorkbook(t *testing.T, setup func(f *excelize.File)) string {
+	t.Helper()
+
+	f := excelize.NewFile()
+	defer func() {
+		_ = f.Close()
+	}()
+	setup(f)
+
+	filename := filepath.Join(t.TempDir(), "book.xlsx")
+	if err := f.SaveAs(filename); err != nil {
+		t.Fatalf("failed to save workbook: %v", err)
+	}
+	return filename
+}
+
+// extract runs QuickExtract on filename
+func extract(t *testing.T, filename string) *excelmetadata.Metadata {
+	t.Helper()
+
+	metadata, err := excelmetadata.QuickExtract(filename)
+	if err != nil {
+		t.Fatalf("failed to extract %s: %v", filename, err)
+	}
+	return metadata
+}
+
+// extractWithOptions extracts filename with options changed by configure
+func extractWithOptions(t *testing.T, filename string, configure func(*excelmetadata.Options)) *excelmetadata.Metadata {
+	t.Helper()
+	options := excelmetadata.DefaultOptions()
+	configure(options)
+	extractor, err := excelmetadata.New(filename, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer extractor.Close()
+
+	metadata, err := extractor.Extract()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return metadata
+}
+
+// rewritePart replaces old with new in a part of the workbook package
+func rewritePart(t *testing.T, filename, part, old, new string) {
+	t.Helper()
+	r, err := zip.OpenReader(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, file := range r.File {
+		rc, err := file.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := io.ReadAll(rc)
+		_ = rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if file.Name == part {
+			if !strings.Contains(string(content), old) {
+				t.Fatalf("%s does not contain %q", part, old)
+			}
+			content = []byte(strings.Replace(string(content), old, new, 1))
+		}
+		fw, err := w.Create(file.Name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := fw.Write(content); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_ = r.Close()
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filename, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// addParts adds parts to the workbook package
+func addParts(t *testing.T, filename string, parts map[string]string) {
+	t.Helper()
+	r, err := zip.OpenReader(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	copyFile := func(name string, content []byte) {
+		fw, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := fw.Write(content); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, file := range r.File {
+		rc, err := file.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := io.ReadAll(rc)
+		_ = rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		copyFile(file.Name, content)
+	}
+	for name, content := range parts {
+		copyFile(name, []byte(content))
+	}
+	_ = r.Close()
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filename, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
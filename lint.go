@@ -0,0 +1,499 @@
+package excelmetadata
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Lint severities, from the most to the least severe. SeverityOff
+// disables a rule.
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+	SeverityInfo    = "info"
+	SeverityOff     = "off"
+)
+
+// Built-in lint rules
+const (
+	RuleErrorValue        = "error-value"
+	RuleHardcodedNumber   = "hardcoded-number"
+	RuleVolatileFunction  = "volatile-function"
+	RuleHiddenSheet       = "hidden-sheet"
+	RuleVeryHiddenSheet   = "very-hidden-sheet"
+	RuleExternalLink      = "external-link"
+	RuleMergedCellInTable = "merged-cell-in-table"
+	RuleBrokenName        = "broken-defined-name"
+	RuleValidationMessage = "validation-without-error-message"
+)
+
+// LintFinding is a problem reported by a lint rule
+type LintFinding struct {
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+	// Sheet and Ref locate the finding: a cell, a range or a defined name.
+	// Ref is empty for findings about a whole sheet.
+	Sheet string `json:"sheet,omitempty"`
+	Ref   string `json:"ref,omitempty"`
+}
+
+// Location renders the sheet and reference of a finding as Sheet!Ref
+func (f LintFinding) Location() string {
+	switch {
+	case f.Sheet == "":
+		return f.Ref
+	case f.Ref == "":
+		return strings.TrimSuffix(quoteReference(f.Sheet+"!"), "!")
+	}
+	return quoteReference(f.Sheet + "!" + f.Ref)
+}
+
+// LintRule checks metadata. Findings only need Message, Sheet and Ref, Lint
+// fills in the rule and its severity.
+type LintRule interface {
+	Check(metadata *Metadata, config LintRuleConfig) []LintFinding
+}
+
+// LintRuleFunc adapts a function to the LintRule interface
+type LintRuleFunc func(metadata *Metadata, config LintRuleConfig) []LintFinding
+
+// Check calls f(metadata, config)
+func (f LintRuleFunc) Check(metadata *Metadata, config LintRuleConfig) []LintFinding {
+	return f(metadata, config)
+}
+
+// LintRuleInfo describes a registered lint rule
+type LintRuleInfo struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	Severity    string `json:"severity"`
+}
+
+// LintConfig configures the rules run by Lint. Rules missing from Rules run
+// with their default severity.
+type LintConfig struct {
+	Rules map[string]LintRuleConfig `yaml:"rules"`
+}
+
+// LintRuleConfig overrides the severity of a rule and carries its
+// rule-specific options. In YAML a bare severity is accepted as well:
+//
+//	rules:
+//	  hidden-sheet: off
+//	  hardcoded-number:
+//	    severity: error
+//	    allow: [0, 1, 12, 100]
+type LintRuleConfig struct {
+	Severity string                 `yaml:"severity"`
+	Options  map[string]interface{} `yaml:",inline"`
+}
+
+// UnmarshalYAML accepts a severity or a mapping
+func (c *LintRuleConfig) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		return node.Decode(&c.Severity)
+	}
+	type plain LintRuleConfig
+	return node.Decode((*plain)(c))
+}
+
+// Strings returns a list option as strings
+func (c LintRuleConfig) Strings(key string) []string {
+	values, _ := c.Options[key].([]interface{})
+	var list []string
+	for _, v := range values {
+		list = append(list, fmt.Sprint(v))
+	}
+	return list
+}
+
+type registeredLintRule struct {
+	info LintRuleInfo
+	rule LintRule
+}
+
+var (
+	lintRulesMu sync.RWMutex
+	lintRules   = map[string]registeredLintRule{}
+)
+
+func init() {
+	RegisterLintRule(LintRuleInfo{ID: RuleErrorValue, Description: "Cells holding an error value such as #REF! or #DIV/0!", Severity: SeverityError}, LintRuleFunc(lintErrorValues))
+	RegisterLintRule(LintRuleInfo{ID: RuleHardcodedNumber, Description: "Numbers typed into formulas instead of referenced from cells (option allow lists accepted numbers, default 0 and 1)", Severity: SeverityWarning}, LintRuleFunc(lintHardcodedNumbers))
+	RegisterLintRule(LintRuleInfo{ID: RuleVolatileFunction, Description: "Formulas calling volatile functions that recalculate on every change (option functions replaces the list)", Severity: SeverityWarning}, LintRuleFunc(lintVolatileFunctions))
+	RegisterLintRule(LintRuleInfo{ID: RuleHiddenSheet, Description: "Hidden sheets", Severity: SeverityInfo}, LintRuleFunc(lintHiddenSheets))
+	RegisterLintRule(LintRuleInfo{ID: RuleVeryHiddenSheet, Description: "Very hidden sheets, which can only be shown through VBA", Severity: SeverityWarning}, LintRuleFunc(lintVeryHiddenSheets))
+	RegisterLintRule(LintRuleInfo{ID: RuleExternalLink, Description: "Formulas and defined names referring to other workbooks", Severity: SeverityWarning}, LintRuleFunc(lintExternalLinks))
+	RegisterLintRule(LintRuleInfo{ID: RuleMergedCellInTable, Description: "Merged cells overlapping a table", Severity: SeverityWarning}, LintRuleFunc(lintMergedCellsInTables))
+	RegisterLintRule(LintRuleInfo{ID: RuleBrokenName, Description: "Defined names referring to #REF!", Severity: SeverityError}, LintRuleFunc(lintBrokenNames))
+	RegisterLintRule(LintRuleInfo{ID: RuleValidationMessage, Description: "Data validations that do not show an error message for invalid input", Severity: SeverityInfo}, LintRuleFunc(lintValidationMessages))
+}
+
+// RegisterLintRule makes a rule available to Lint. Registering an existing
+// ID replaces the rule.
+func RegisterLintRule(info LintRuleInfo, rule LintRule) {
+	if info.ID == "" || rule == nil {
+		panic("excelmetadata: RegisterLintRule requires an ID and a rule")
+	}
+	if !validSeverity(info.Severity) {
+		panic(fmt.Sprintf("excelmetadata: invalid severity %q for lint rule %s", info.Severity, info.ID))
+	}
+
+	lintRulesMu.Lock()
+	defer lintRulesMu.Unlock()
+	lintRules[info.ID] = registeredLintRule{info: info, rule: rule}
+}
+
+// LintRules lists the registered lint rules sorted by ID
+func LintRules() []LintRuleInfo {
+	lintRulesMu.RLock()
+	defer lintRulesMu.RUnlock()
+
+	infos := make([]LintRuleInfo, 0, len(lintRules))
+	for _, reg := range lintRules {
+		infos = append(infos, reg.info)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].ID < infos[j].ID })
+	return infos
+}
+
+// LoadLintConfig reads a YAML lint configuration
+func LoadLintConfig(filename string) (*LintConfig, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read lint config: %w", err)
+	}
+	return ParseLintConfig(data)
+}
+
+// ParseLintConfig parses a YAML lint configuration and checks its rule IDs
+// and severities
+func ParseLintConfig(data []byte) (*LintConfig, error) {
+	var config LintConfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("invalid lint config: %w", err)
+	}
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+func (c *LintConfig) validate() error {
+	lintRulesMu.RLock()
+	defer lintRulesMu.RUnlock()
+
+	for id, rule := range c.Rules {
+		if _, ok := lintRules[id]; !ok {
+			return fmt.Errorf("invalid lint config: unknown rule %q", id)
+		}
+		if rule.Severity != "" && !validSeverity(rule.Severity) {
+			return fmt.Errorf("invalid lint config: rule %s has invalid severity %q", id, rule.Severity)
+		}
+	}
+	return nil
+}
+
+func validSeverity(severity string) bool {
+	switch severity {
+	case SeverityError, SeverityWarning, SeverityInfo, SeverityOff:
+		return true
+	}
+	return false
+}
+
+// SeverityAtLeast reports whether severity is as severe as threshold
+func SeverityAtLeast(severity, threshold string) bool {
+	rank := map[string]int{SeverityInfo: 1, SeverityWarning: 2, SeverityError: 3}
+	return rank[severity] > 0 && rank[severity] >= rank[threshold]
+}
+
+// Lint runs the registered rules over metadata, with their default
+// severity unless config overrides it. Findings are ordered by rule ID,
+// then in the order each rule reported them.
+func Lint(metadata *Metadata, config *LintConfig) ([]LintFinding, error) {
+	if metadata == nil {
+		return nil, fmt.Errorf("metadata is nil")
+	}
+	if config == nil {
+		config = &LintConfig{}
+	}
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	lintRulesMu.RLock()
+	rules := make([]registeredLintRule, 0, len(lintRules))
+	for _, reg := range lintRules {
+		rules = append(rules, reg)
+	}
+	lintRulesMu.RUnlock()
+	sort.Slice(rules, func(i, j int) bool { return rules[i].info.ID < rules[j].info.ID })
+
+	var findings []LintFinding
+	for _, reg := range rules {
+		ruleConfig := config.Rules[reg.info.ID]
+		severity := ruleConfig.Severity
+		if severity == "" {
+			severity = reg.info.Severity
+		}
+		if severity == SeverityOff {
+			continue
+		}
+		for _, finding := range reg.rule.Check(metadata, ruleConfig) {
+			finding.Rule, finding.Severity = reg.info.ID, severity
+			findings = append(findings, finding)
+		}
+	}
+	return findings, nil
+}
+
+// Built-in rules
+
+// excelErrors are the error values a cell can hold
+var excelErrors = map[string]bool{
+	"#NULL!": true, "#DIV/0!": true, "#VALUE!": true, "#REF!": true, "#NAME?": true,
+	"#NUM!": true, "#N/A": true, "#GETTING_DATA": true, "#SPILL!": true, "#CALC!": true,
+	"#FIELD!": true, "#BLOCKED!": true, "#CONNECT!": true, "#UNKNOWN!": true, "#BUSY!": true,
+}
+
+func lintErrorValues(metadata *Metadata, _ LintRuleConfig) []LintFinding {
+	var findings []LintFinding
+	for _, sheet := range metadata.Sheets {
+		for _, cell := range sheet.Cells {
+			value := fmt.Sprint(cell.Value)
+			if info := cell.FormulaInfo; info != nil && info.CachedType == "e" {
+				value = info.CachedValue
+			}
+			if !excelErrors[strings.ToUpper(value)] {
+				continue
+			}
+			message := "cell holds " + value
+			if cell.Formula != "" {
+				message = fmt.Sprintf("formula =%s evaluates to %s", cell.Formula, value)
+			}
+			findings = append(findings, LintFinding{Message: message, Sheet: sheet.Name, Ref: cell.Address})
+		}
+	}
+	return findings
+}
+
+func lintHardcodedNumbers(metadata *Metadata, config LintRuleConfig) []LintFinding {
+	allowed := map[float64]bool{0: true, 1: true}
+	if list, ok := config.Options["allow"]; ok && list != nil {
+		allowed = map[float64]bool{}
+		for _, v := range config.Strings("allow") {
+			if n, err := strconv.ParseFloat(v, 64); err == nil {
+				allowed[n] = true
+			}
+		}
+	}
+
+	var findings []LintFinding
+	forEachFormula(metadata, func(sheet SheetMetadata, cell CellMetadata, ast *FormulaAST) {
+		var numbers []string
+		ast.Walk(func(n *FormulaNode) bool {
+			if n.Kind == NodeNumber {
+				if v, err := strconv.ParseFloat(n.Value, 64); err == nil && !allowed[v] {
+					numbers = append(numbers, n.Value)
+				}
+			}
+			return true
+		})
+		if len(numbers) > 0 {
+			findings = append(findings, LintFinding{
+				Message: fmt.Sprintf("formula =%s contains hard-coded %s", cell.Formula, strings.Join(numbers, ", ")),
+				Sheet:   sheet.Name,
+				Ref:     cell.Address,
+			})
+		}
+	})
+	return findings
+}
+
+// volatileFunctions recalculate whenever any cell of the workbook changes
+var volatileFunctions = []string{"NOW", "TODAY", "RAND", "RANDBETWEEN", "RANDARRAY", "OFFSET", "INDIRECT", "INFO", "CELL"}
+
+func lintVolatileFunctions(metadata *Metadata, config LintRuleConfig) []LintFinding {
+	volatile := make(map[string]bool)
+	names := volatileFunctions
+	if _, ok := config.Options["functions"]; ok {
+		names = config.Strings("functions")
+	}
+	for _, name := range names {
+		volatile[strings.ToUpper(name)] = true
+	}
+
+	var findings []LintFinding
+	forEachFormula(metadata, func(sheet SheetMetadata, cell CellMetadata, ast *FormulaAST) {
+		var used []string
+		for _, function := range ast.Functions() {
+			if volatile[function] {
+				used = append(used, function)
+			}
+		}
+		if len(used) > 0 {
+			findings = append(findings, LintFinding{
+				Message: fmt.Sprintf("formula =%s calls volatile %s", cell.Formula, strings.Join(used, ", ")),
+				Sheet:   sheet.Name,
+				Ref:     cell.Address,
+			})
+		}
+	})
+	return findings
+}
+
+// forEachFormula calls fn for every formula cell that parses
+func forEachFormula(metadata *Metadata, fn func(sheet SheetMetadata, cell CellMetadata, ast *FormulaAST)) {
+	for _, sheet := range metadata.Sheets {
+		for _, cell := range sheet.Cells {
+			if cell.Formula == "" {
+				continue
+			}
+			if ast, err := ParseFormula(cell.Formula); err == nil {
+				fn(sheet, cell, ast)
+			}
+		}
+	}
+}
+
+func lintHiddenSheets(metadata *Metadata, _ LintRuleConfig) []LintFinding {
+	var findings []LintFinding
+	for _, sheet := range metadata.Sheets {
+		if !sheet.Visible && sheet.State != SheetVeryHidden {
+			findings = append(findings, LintFinding{Message: "sheet is hidden", Sheet: sheet.Name})
+		}
+	}
+	return findings
+}
+
+func lintVeryHiddenSheets(metadata *Metadata, _ LintRuleConfig) []LintFinding {
+	var findings []LintFinding
+	for _, sheet := range metadata.Sheets {
+		if sheet.State == SheetVeryHidden {
+			findings = append(findings, LintFinding{Message: "sheet is very hidden", Sheet: sheet.Name})
+		}
+	}
+	return findings
+}
+
+// externalReference matches references into another workbook, stored as
+// [1]Sheet1!A1 or written as 'C:\path\[Book.xlsx]Sheet1'!A1
+var externalReference = regexp.MustCompile(`\[[^\[\]]+\][^!\[\]]*!`)
+
+// externalReferences returns the references of a formula that point into
+// other workbooks
+func externalReferences(formula string) []string {
+	ast, err := ParseFormula(formula)
+	if err != nil {
+		return nil
+	}
+	var refs []string
+	for _, ref := range ast.References() {
+		if externalReference.MatchString(ref) {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+func lintExternalLinks(metadata *Metadata, _ LintRuleConfig) []LintFinding {
+	var findings []LintFinding
+	for _, dn := range metadata.DefinedNames {
+		if refs := externalReferences(dn.RefersTo); len(refs) > 0 {
+			findings = append(findings, LintFinding{
+				Message: fmt.Sprintf("defined name %s refers to another workbook: %s", dn.Name, strings.Join(refs, ", ")),
+				Sheet:   nameSheet(dn),
+				Ref:     dn.Name,
+			})
+		}
+	}
+	for _, sheet := range metadata.Sheets {
+		for _, cell := range sheet.Cells {
+			if cell.Formula == "" {
+				continue
+			}
+			if refs := externalReferences(cell.Formula); len(refs) > 0 {
+				findings = append(findings, LintFinding{
+					Message: fmt.Sprintf("formula refers to another workbook: %s", strings.Join(refs, ", ")),
+					Sheet:   sheet.Name,
+					Ref:     cell.Address,
+				})
+			}
+		}
+	}
+	return findings
+}
+
+// nameSheet returns the sheet a defined name is local to, "" for names of
+// the workbook
+func nameSheet(dn DefinedName) string {
+	if dn.Scope == "Workbook" {
+		return ""
+	}
+	return dn.Scope
+}
+
+func lintMergedCellsInTables(metadata *Metadata, _ LintRuleConfig) []LintFinding {
+	var findings []LintFinding
+	for _, sheet := range metadata.Sheets {
+		for _, table := range sheet.Tables {
+			tableRange, err := parseCellRange(table.Range)
+			if err != nil {
+				continue
+			}
+			for _, mc := range sheet.MergedCells {
+				ref := mc.StartCell + ":" + mc.EndCell
+				if tableRange.overlaps(ref) {
+					findings = append(findings, LintFinding{
+						Message: fmt.Sprintf("merged cells %s overlap table %s (%s)", ref, table.Name, table.Range),
+						Sheet:   sheet.Name,
+						Ref:     ref,
+					})
+				}
+			}
+		}
+	}
+	return findings
+}
+
+func lintBrokenNames(metadata *Metadata, _ LintRuleConfig) []LintFinding {
+	var findings []LintFinding
+	for _, dn := range metadata.DefinedNames {
+		if strings.Contains(strings.ToUpper(dn.RefersTo), "#REF!") {
+			findings = append(findings, LintFinding{
+				Message: fmt.Sprintf("defined name %s refers to %s", dn.Name, dn.RefersTo),
+				Sheet:   nameSheet(dn),
+				Ref:     dn.Name,
+			})
+		}
+	}
+	return findings
+}
+
+func lintValidationMessages(metadata *Metadata, _ LintRuleConfig) []LintFinding {
+	var findings []LintFinding
+	for _, sheet := range metadata.Sheets {
+		for _, dv := range sheet.DataValidations {
+			var message string
+			switch {
+			case !dv.ShowError:
+				message = fmt.Sprintf("%s validation accepts invalid input without an error", dv.Type)
+			case dv.ErrorMessage == nil || *dv.ErrorMessage == "":
+				message = fmt.Sprintf("%s validation has no error message", dv.Type)
+			default:
+				continue
+			}
+			findings = append(findings, LintFinding{Message: message, Sheet: sheet.Name, Ref: dv.Range})
+		}
+	}
+	return findings
+}
@@ -0,0 +1,178 @@
+package excelmetadata_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/prongbang/excelmetadata"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestLint(t *testing.T) {
+	metadata := extract(t, newWorkbook(t, func(f *excelize.File) {
+		for _, sheet := range []string{"Hidden", "Secret"} {
+			if _, err := f.NewSheet(sheet); err != nil {
+				t.Fatal(err)
+			}
+		}
+		_ = f.SetSheetVisible("Hidden", false)
+		_ = f.SetSheetVisible("Secret", false, true)
+
+		_ = f.SetCellValue("Sheet1", "A1", "Item")
+		_ = f.SetCellValue("Sheet1", "B1", "Price")
+		_ = f.SetCellValue("Sheet1", "A2", "Pen")
+		_ = f.SetCellValue("Sheet1", "B2", 2)
+		_ = f.AddTable("Sheet1", &excelize.Table{Range: "A1:B3", Name: "Prices"})
+		_ = f.MergeCell("Sheet1", "A3", "B3")
+
+		formula := func(cell, formula string) {
+			_ = f.SetCellValue("Sheet1", cell, 1)
+			_ = f.SetCellFormula("Sheet1", cell, formula)
+		}
+		formula("C2", "B2*1.07+1")
+		formula("D2", "TODAY()-B2")
+		formula("E2", "[1]Rates!A1*B2")
+		_ = f.SetCellValue("Sheet1", "F2", "#DIV/0!")
+
+		_ = f.SetDefinedName(&excelize.DefinedName{Name: "Broken", RefersTo: "Sheet1!#REF!"})
+
+		dv := excelize.NewDataValidation(true)
+		dv.Sqref = "G2:G10"
+		_ = dv.SetRange(1, 10, excelize.DataValidationTypeWhole, excelize.DataValidationOperatorBetween)
+		_ = f.AddDataValidation("Sheet1", dv)
+	}))
+
+	tests := []struct {
+		name   string
+		config string
+		want   []string
+		// hardcoded is the message of the hard-coded number finding
+		hardcoded string
+		wantErr   bool
+	}{
+		{
+			name: "default rules",
+			want: []string{
+				"broken-defined-name error Broken",
+				"error-value error Sheet1!F2",
+				"external-link warning Sheet1!E2",
+				"hardcoded-number warning Sheet1!C2",
+				"hidden-sheet info Hidden",
+				"merged-cell-in-table warning Sheet1!A3:B3",
+				"validation-without-error-message info Sheet1!G2:G10",
+				"very-hidden-sheet warning Secret",
+				"volatile-function warning Sheet1!D2",
+			},
+			hardcoded: "formula =B2*1.07+1 contains hard-coded 1.07",
+		},
+		{
+			name: "config",
+			config: `
+rules:
+  hidden-sheet: off
+  very-hidden-sheet: error
+  hardcoded-number:
+    allow: [1.07]
+  volatile-function:
+    severity: info
+    functions: [RAND]
+`,
+			want: []string{
+				"broken-defined-name error Broken",
+				"error-value error Sheet1!F2",
+				"external-link warning Sheet1!E2",
+				"hardcoded-number warning Sheet1!C2",
+				"merged-cell-in-table warning Sheet1!A3:B3",
+				"validation-without-error-message info Sheet1!G2:G10",
+				"very-hidden-sheet error Secret",
+			},
+			hardcoded: "formula =B2*1.07+1 contains hard-coded 1",
+		},
+		{
+			name:    "unknown rule",
+			config:  "rules:\n  no-such-rule: error\n",
+			wantErr: true,
+		},
+		{
+			name:    "unknown severity",
+			config:  "rules:\n  hidden-sheet: fatal\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var config *excelmetadata.LintConfig
+			if tt.config != "" {
+				var err error
+				config, err = excelmetadata.ParseLintConfig([]byte(tt.config))
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("ParseLintConfig() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if err != nil {
+					return
+				}
+			}
+			findings, err := excelmetadata.Lint(metadata, config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, f := range findings {
+				got = append(got, f.Rule+" "+f.Severity+" "+f.Location())
+				if f.Rule == excelmetadata.RuleHardcodedNumber && f.Message != tt.hardcoded {
+					t.Errorf("hard-coded number message = %q, want %q", f.Message, tt.hardcoded)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("findings =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteSARIF(t *testing.T) {
+	findings := []excelmetadata.LintFinding{
+		{Rule: excelmetadata.RuleErrorValue, Severity: excelmetadata.SeverityError, Message: "cell holds #REF!", Sheet: "Q1 Data", Ref: "B2"},
+		{Rule: excelmetadata.RuleHiddenSheet, Severity: excelmetadata.SeverityInfo, Message: "sheet is hidden", Sheet: "Hidden"},
+	}
+	var buf bytes.Buffer
+	if err := excelmetadata.WriteSARIF(&buf, "book.xlsx", findings); err != nil {
+		t.Fatal(err)
+	}
+
+	var log struct {
+		Version string `json:"version"`
+		Runs    []struct {
+			Tool struct {
+				Driver struct {
+					Rules []struct {
+						ID string `json:"id"`
+					} `json:"rules"`
+				} `json:"driver"`
+			} `json:"tool"`
+			Results []struct {
+				RuleID    string `json:"ruleId"`
+				Level     string `json:"level"`
+				Locations []struct {
+					LogicalLocations []struct {
+						FullyQualifiedName string `json:"fullyQualifiedName"`
+					} `json:"logicalLocations"`
+				} `json:"locations"`
+			} `json:"results"`
+		} `json:"runs"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatal(err)
+	}
+	if log.Version != "2.1.0" || len(log.Runs) != 1 || len(log.Runs[0].Tool.Driver.Rules) != len(excelmetadata.LintRules()) {
+		t.Fatalf("unexpected SARIF log:\n%s", buf.String())
+	}
+	results := log.Runs[0].Results
+	if len(results) != 2 || results[0].Level != "error" || results[1].Level != "note" ||
+		results[0].Locations[0].LogicalLocations[0].FullyQualifiedName != "'Q1 Data'!B2" {
+		t.Errorf("unexpected SARIF results:\n%s", buf.String())
+	}
+}
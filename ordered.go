@@ -0,0 +1,77 @@
+package excelmetadata
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// orderedMember is a key/value pair of an orderedObject
+type orderedMember struct {
+	Key   string
+	Value interface{}
+}
+
+// orderedObject is a JSON object that keeps its members in document order.
+// Encoders other than JSON walk this tree so they share the JSON field
+// names, omissions and ordering.
+type orderedObject []orderedMember
+
+// toOrderedTree marshals v to JSON and decodes it into a tree of
+// orderedObject, []interface{}, string, json.Number, bool and nil values
+func toOrderedTree(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal to JSON: %w", err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return decodeOrdered(dec)
+}
+
+func decodeOrdered(dec *json.Decoder) (interface{}, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t := tok.(type) {
+	case json.Delim:
+		switch t {
+		case '{':
+			obj := orderedObject{}
+			for dec.More() {
+				keyTok, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				value, err := decodeOrdered(dec)
+				if err != nil {
+					return nil, err
+				}
+				obj = append(obj, orderedMember{Key: keyTok.(string), Value: value})
+			}
+			if _, err := dec.Token(); err != nil {
+				return nil, err
+			}
+			return obj, nil
+		case '[':
+			arr := []interface{}{}
+			for dec.More() {
+				value, err := decodeOrdered(dec)
+				if err != nil {
+					return nil, err
+				}
+				arr = append(arr, value)
+			}
+			if _, err := dec.Token(); err != nil {
+				return nil, err
+			}
+			return arr, nil
+		}
+		return nil, fmt.Errorf("unexpected delimiter %v", t)
+	default:
+		return t, nil
+	}
+}
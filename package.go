@@ -0,0 +1,237 @@
+package excelmetadata
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// xmlRelationships is the content of a .rels package part
+type xmlRelationships struct {
+	Relationships []struct {
+		ID         string `xml:"Id,attr"`
+		Type       string `xml:"Type,attr"`
+		Target     string `xml:"Target,attr"`
+		TargetMode string `xml:"TargetMode,attr"`
+	} `xml:"Relationship"`
+}
+
+// readPart returns the raw content of a package part, nil when missing.
+// excelize keeps worksheets larger than its UnzipXMLSizeLimit in temporary
+// files until a sheet is loaded, so parts missing from the package are read
+// from the archive.
+func (e *Extractor) readPart(name string) []byte {
+	name = strings.TrimPrefix(name, "/")
+	if v, ok := e.file.Pkg.Load(name); ok {
+		if content, ok := v.([]byte); ok {
+			return content
+		}
+	}
+
+	e.archiveOnce.Do(func() {
+		e.archive, _ = zip.OpenReader(e.filename)
+	})
+	if e.archive == nil {
+		return nil
+	}
+	for _, file := range e.archive.File {
+		if strings.ReplaceAll(file.Name, "\\", "/") != name {
+			continue
+		}
+		rc, err := file.Open()
+		if err != nil {
+			return nil
+		}
+		defer rc.Close()
+		content, err := io.ReadAll(rc)
+		if err != nil {
+			return nil
+		}
+		return content
+	}
+	return nil
+}
+
+// readRelationships parses the relationships of a part, e.g. the
+// relationships of xl/workbook.xml are stored in xl/_rels/workbook.xml.rels
+func (e *Extractor) readRelationships(part string) xmlRelationships {
+	var rels xmlRelationships
+	dir, file := path.Split(part)
+	_ = xml.Unmarshal(e.readPart(path.Join(dir, "_rels", file+".rels")), &rels)
+	return rels
+}
+
+// resolvePart turns a relationship target into a package path
+func resolvePart(source, target string) string {
+	if strings.HasPrefix(target, "/") {
+		return strings.TrimPrefix(target, "/")
+	}
+	return path.Join(path.Dir(source), target)
+}
+
+// workbookPart returns the package path of the workbook part
+func (e *Extractor) workbookPart() string {
+	for _, rel := range e.readRelationships("").Relationships {
+		if strings.HasSuffix(rel.Type, "/officeDocument") {
+			return resolvePart("", rel.Target)
+		}
+	}
+	return "xl/workbook.xml"
+}
+
+// Sheet visibility states
+const (
+	SheetHidden     = "hidden"
+	SheetVeryHidden = "veryHidden"
+)
+
+// sheetParts maps sheet names to the package paths of their worksheet parts
+func (e *Extractor) sheetParts() map[string]string {
+	e.loadSheetParts()
+	return e.parts
+}
+
+// sheetState returns the visibility state of a sheet, "" when visible
+func (e *Extractor) sheetState(sheetName string) string {
+	e.loadSheetParts()
+	return e.states[sheetName]
+}
+
+// loadSheetParts reads the sheets of the workbook part once
+func (e *Extractor) loadSheetParts() {
+	e.partsOnce.Do(func() {
+		e.parts = make(map[string]string)
+		e.states = make(map[string]string)
+
+		workbook := e.workbookPart()
+		var content struct {
+			Sheets []struct {
+				Name  string `xml:"name,attr"`
+				State string `xml:"state,attr"`
+				RID   string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+			} `xml:"sheets>sheet"`
+		}
+		if err := xml.Unmarshal(e.readPart(workbook), &content); err != nil {
+			return
+		}
+		targets := make(map[string]string)
+		for _, rel := range e.readRelationships(workbook).Relationships {
+			targets[rel.ID] = resolvePart(workbook, rel.Target)
+		}
+		for _, sheet := range content.Sheets {
+			if target, ok := targets[sheet.RID]; ok {
+				e.parts[sheet.Name] = target
+			}
+			if sheet.State == SheetHidden || sheet.State == SheetVeryHidden {
+				e.states[sheet.Name] = sheet.State
+			}
+		}
+	})
+}
+
+// scanCellBounds returns the range spanned by every cell element of a
+// worksheet part, including styled cells without a value, or "" when the
+// sheet has no cells
+func scanCellBounds(content []byte) string {
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	var (
+		row, col       int
+		minRow, minCol int
+		maxRow, maxCol int
+		found          bool
+	)
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			break
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch start.Name.Local {
+		case "row":
+			row++
+			col = 0
+			if r, err := strconv.Atoi(attrValue(start, "r")); err == nil {
+				row = r
+			}
+		case "c":
+			col++
+			if c, r, err := excelize.CellNameToCoordinates(attrValue(start, "r")); err == nil {
+				col, row = c, r
+			}
+			if !found || row < minRow {
+				minRow = row
+			}
+			if !found || col < minCol {
+				minCol = col
+			}
+			if row > maxRow {
+				maxRow = row
+			}
+			if col > maxCol {
+				maxCol = col
+			}
+			found = true
+		}
+	}
+	if !found {
+		return ""
+	}
+	from, _ := excelize.CoordinatesToCellName(minCol, minRow)
+	to, _ := excelize.CoordinatesToCellName(maxCol, maxRow)
+	return from + ":" + to
+}
+
+// scanSheetProtection returns the sheet protection of a worksheet part, nil
+// when the sheet is not protected. With withPassword, Password holds the
+// stored password hash: the legacy 16 bit hash, or the hash value of the
+// algorithm in use.
+func scanSheetProtection(content []byte, withPassword bool) *SheetProtection {
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "sheetProtection" {
+			continue
+		}
+		on := func(name string) bool {
+			v := attrValue(start, name)
+			return v == "1" || v == "true"
+		}
+		var password string
+		if withPassword {
+			password = attrValue(start, "password")
+			if hash := attrValue(start, "hashValue"); hash != "" {
+				password = hash
+			}
+		}
+		return &SheetProtection{
+			Protected:           on("sheet"),
+			Password:            password,
+			EditObjects:         !on("objects"),
+			EditScenarios:       !on("scenarios"),
+			SelectLockedCells:   !on("selectLockedCells"),
+			SelectUnlockedCells: !on("selectUnlockedCells"),
+		}
+	}
+}
+
+func attrValue(start xml.StartElement, name string) string {
+	for _, attr := range start.Attr {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
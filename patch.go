@@ -0,0 +1,526 @@
+package excelmetadata
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Patch operation names defined by RFC 6902
+const (
+	PatchOpAdd     = "add"
+	PatchOpRemove  = "remove"
+	PatchOpReplace = "replace"
+	PatchOpMove    = "move"
+	PatchOpCopy    = "copy"
+	PatchOpTest    = "test"
+)
+
+// PatchOperation is a single RFC 6902 JSON Patch operation
+type PatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	From  string      `json:"from,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// Patch is an RFC 6902 JSON Patch document over the keyed metadata document.
+//
+// Paths address sheets by name and cells by address rather than by slice
+// index, e.g. "/sheets/Sheet1/cells/B2/value", so a patch stays valid when
+// rows or sheets are inserted before the patched element.
+type Patch []PatchOperation
+
+// MarshalJSON always emits "value" for operations that require it, even
+// when the value is null
+func (op PatchOperation) MarshalJSON() ([]byte, error) {
+	out := map[string]interface{}{
+		"op":   op.Op,
+		"path": op.Path,
+	}
+	switch op.Op {
+	case PatchOpAdd, PatchOpReplace, PatchOpTest:
+		out["value"] = op.Value
+	case PatchOpMove, PatchOpCopy:
+		out["from"] = op.From
+	}
+	return json.Marshal(out)
+}
+
+// ParsePatch decodes an RFC 6902 JSON Patch document
+func ParsePatch(data []byte) (Patch, error) {
+	var patch Patch
+	if err := json.Unmarshal(data, &patch); err != nil {
+		return nil, fmt.Errorf("failed to parse patch: %w", err)
+	}
+	return patch, nil
+}
+
+// Diff returns the JSON Patch that transforms base into target
+func Diff(base, target *Metadata) (Patch, error) {
+	from, err := toKeyedDocument(base)
+	if err != nil {
+		return nil, err
+	}
+	to, err := toKeyedDocument(target)
+	if err != nil {
+		return nil, err
+	}
+
+	patch := Patch{}
+	diffValues("", from, to, &patch)
+	return patch, nil
+}
+
+// ApplyPatch applies patch to a copy of meta and returns the patched metadata.
+// Keyed collections are restored in canonical order: sheets by index, cells
+// by row then column and the remaining collections by key.
+func ApplyPatch(meta *Metadata, patch Patch) (*Metadata, error) {
+	doc, err := toKeyedDocument(meta)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, op := range patch {
+		doc, err = applyOperation(doc, op)
+		if err != nil {
+			return nil, fmt.Errorf("patch operation %d (%s %s): %w", i, op.Op, op.Path, err)
+		}
+	}
+
+	return fromKeyedDocument(doc)
+}
+
+// Keyed document conversion
+
+// keyedSheetCollections lists the per-sheet collections and how each item is keyed
+var keyedSheetCollections = []struct {
+	name string
+	key  func(item map[string]interface{}) string
+}{
+	{"cells", func(item map[string]interface{}) string { return stringField(item, "address") }},
+	{"mergedCells", func(item map[string]interface{}) string {
+		return stringField(item, "startCell") + ":" + stringField(item, "endCell")
+	}},
+	{"tables", func(item map[string]interface{}) string { return stringField(item, "name") }},
+	{"comments", func(item map[string]interface{}) string { return stringField(item, "cell") }},
+	{"dataValidations", func(item map[string]interface{}) string { return stringField(item, "range") }},
+	{"images", func(item map[string]interface{}) string { return stringField(item, "cell") }},
+}
+
+func definedNameKey(item map[string]interface{}) string {
+	if scope := stringField(item, "scope"); scope != "" {
+		return scope + "!" + stringField(item, "name")
+	}
+	return stringField(item, "name")
+}
+
+func toKeyedDocument(meta *Metadata) (interface{}, error) {
+	if meta == nil {
+		return nil, fmt.Errorf("metadata is nil")
+	}
+
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	if sheets, ok := doc["sheets"].([]interface{}); ok {
+		for _, s := range sheets {
+			sheet, ok := s.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, c := range keyedSheetCollections {
+				if items, ok := sheet[c.name].([]interface{}); ok {
+					sheet[c.name] = keyItems(items, c.key)
+				}
+			}
+		}
+		doc["sheets"] = keyItems(sheets, func(item map[string]interface{}) string {
+			return stringField(item, "name")
+		})
+	}
+	if names, ok := doc["definedNames"].([]interface{}); ok {
+		doc["definedNames"] = keyItems(names, definedNameKey)
+	}
+
+	return doc, nil
+}
+
+func fromKeyedDocument(doc interface{}) (*Metadata, error) {
+	root, ok := doc.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("patched document is not an object")
+	}
+
+	if sheets, ok := root["sheets"].(map[string]interface{}); ok {
+		for _, s := range sheets {
+			sheet, ok := s.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, c := range keyedSheetCollections {
+				if items, ok := sheet[c.name].(map[string]interface{}); ok {
+					less := lessKey
+					if c.name == "cells" {
+						less = lessCellAddress
+					}
+					sheet[c.name] = unkeyItems(items, less)
+				}
+			}
+		}
+		list := unkeyItems(sheets, lessKey)
+		sort.SliceStable(list, func(i, j int) bool {
+			return numberField(list[i], "index") < numberField(list[j], "index")
+		})
+		root["sheets"] = list
+	}
+	if names, ok := root["definedNames"].(map[string]interface{}); ok {
+		root["definedNames"] = unkeyItems(names, lessKey)
+	}
+
+	data, err := json.Marshal(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal patched document: %w", err)
+	}
+	var meta Metadata
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return nil, fmt.Errorf("failed to decode patched metadata: %w", err)
+	}
+	if meta.Sheets == nil {
+		meta.Sheets = []SheetMetadata{}
+	}
+	return &meta, nil
+}
+
+// keyItems turns a list of objects into an object keyed by key(item).
+// Duplicate keys get a "#n" suffix in order of appearance.
+func keyItems(items []interface{}, key func(map[string]interface{}) string) map[string]interface{} {
+	keyed := make(map[string]interface{}, len(items))
+	for _, it := range items {
+		item, ok := it.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		k := key(item)
+		if _, exists := keyed[k]; exists {
+			for n := 2; ; n++ {
+				candidate := fmt.Sprintf("%s#%d", k, n)
+				if _, exists := keyed[candidate]; !exists {
+					k = candidate
+					break
+				}
+			}
+		}
+		keyed[k] = item
+	}
+	return keyed
+}
+
+func unkeyItems(keyed map[string]interface{}, less func(a, b string) bool) []interface{} {
+	keys := make([]string, 0, len(keyed))
+	for k := range keyed {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return less(keys[i], keys[j]) })
+
+	items := make([]interface{}, 0, len(keys))
+	for _, k := range keys {
+		items = append(items, keyed[k])
+	}
+	return items
+}
+
+func lessKey(a, b string) bool {
+	return a < b
+}
+
+func lessCellAddress(a, b string) bool {
+	colA, rowA, errA := excelize.CellNameToCoordinates(a)
+	colB, rowB, errB := excelize.CellNameToCoordinates(b)
+	if errA != nil || errB != nil {
+		return a < b
+	}
+	if rowA != rowB {
+		return rowA < rowB
+	}
+	return colA < colB
+}
+
+func stringField(item map[string]interface{}, name string) string {
+	s, _ := item[name].(string)
+	return s
+}
+
+func numberField(item interface{}, name string) float64 {
+	m, _ := item.(map[string]interface{})
+	n, _ := m[name].(float64)
+	return n
+}
+
+// Diffing
+
+func diffValues(path string, from, to interface{}, patch *Patch) {
+	fromMap, fromIsMap := from.(map[string]interface{})
+	toMap, toIsMap := to.(map[string]interface{})
+	if !fromIsMap || !toIsMap {
+		if !reflect.DeepEqual(from, to) {
+			*patch = append(*patch, PatchOperation{Op: PatchOpReplace, Path: path, Value: to})
+		}
+		return
+	}
+
+	keys := make([]string, 0, len(fromMap)+len(toMap))
+	for k := range fromMap {
+		keys = append(keys, k)
+	}
+	for k := range toMap {
+		if _, ok := fromMap[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		childPath := path + "/" + escapePointerToken(k)
+		fromValue, inFrom := fromMap[k]
+		toValue, inTo := toMap[k]
+		switch {
+		case !inTo:
+			*patch = append(*patch, PatchOperation{Op: PatchOpRemove, Path: childPath})
+		case !inFrom:
+			*patch = append(*patch, PatchOperation{Op: PatchOpAdd, Path: childPath, Value: toValue})
+		default:
+			diffValues(childPath, fromValue, toValue, patch)
+		}
+	}
+}
+
+// Applying
+
+func applyOperation(doc interface{}, op PatchOperation) (interface{}, error) {
+	tokens, err := parsePointer(op.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case PatchOpAdd:
+		return addValue(doc, tokens, cloneValue(op.Value))
+	case PatchOpRemove:
+		doc, _, err = removeValue(doc, tokens)
+		return doc, err
+	case PatchOpReplace:
+		if _, err := getValue(doc, tokens); err != nil {
+			return nil, err
+		}
+		if doc, _, err = removeValue(doc, tokens); err != nil {
+			return nil, err
+		}
+		return addValue(doc, tokens, cloneValue(op.Value))
+	case PatchOpMove:
+		fromTokens, err := parsePointer(op.From)
+		if err != nil {
+			return nil, err
+		}
+		if strings.HasPrefix(op.Path+"/", op.From+"/") && op.Path != op.From {
+			return nil, fmt.Errorf("cannot move %q into one of its children", op.From)
+		}
+		doc, value, err := removeValue(doc, fromTokens)
+		if err != nil {
+			return nil, err
+		}
+		return addValue(doc, tokens, value)
+	case PatchOpCopy:
+		fromTokens, err := parsePointer(op.From)
+		if err != nil {
+			return nil, err
+		}
+		value, err := getValue(doc, fromTokens)
+		if err != nil {
+			return nil, err
+		}
+		return addValue(doc, tokens, cloneValue(value))
+	case PatchOpTest:
+		value, err := getValue(doc, tokens)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(value, cloneValue(op.Value)) {
+			return nil, fmt.Errorf("test failed")
+		}
+		return doc, nil
+	default:
+		return nil, fmt.Errorf("unsupported operation %q", op.Op)
+	}
+}
+
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = unescapePointerToken(token)
+	}
+	return tokens, nil
+}
+
+func escapePointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+func unescapePointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+}
+
+func getValue(doc interface{}, tokens []string) (interface{}, error) {
+	current := doc
+	for _, token := range tokens {
+		switch node := current.(type) {
+		case map[string]interface{}:
+			value, ok := node[token]
+			if !ok {
+				return nil, fmt.Errorf("path member %q not found", token)
+			}
+			current = value
+		case []interface{}:
+			idx, err := arrayIndex(token, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+			current = node[idx]
+		default:
+			return nil, fmt.Errorf("cannot traverse into %q", token)
+		}
+	}
+	return current, nil
+}
+
+// addValue sets value at tokens and returns the (possibly new) document root
+func addValue(doc interface{}, tokens []string, value interface{}) (interface{}, error) {
+	if len(tokens) == 0 {
+		return value, nil
+	}
+
+	parent, err := getValue(doc, tokens[:len(tokens)-1])
+	if err != nil {
+		return nil, err
+	}
+	last := tokens[len(tokens)-1]
+
+	switch node := parent.(type) {
+	case map[string]interface{}:
+		node[last] = value
+		return doc, nil
+	case []interface{}:
+		idx := len(node)
+		if last != "-" {
+			if idx, err = arrayIndex(last, len(node)); err != nil {
+				return nil, err
+			}
+		}
+		node = append(node, nil)
+		copy(node[idx+1:], node[idx:])
+		node[idx] = value
+		return setValue(doc, tokens[:len(tokens)-1], node)
+	default:
+		return nil, fmt.Errorf("cannot add %q to a non-container value", last)
+	}
+}
+
+// removeValue deletes the value at tokens and returns the new root and the removed value
+func removeValue(doc interface{}, tokens []string) (interface{}, interface{}, error) {
+	if len(tokens) == 0 {
+		return nil, doc, nil
+	}
+
+	parent, err := getValue(doc, tokens[:len(tokens)-1])
+	if err != nil {
+		return nil, nil, err
+	}
+	last := tokens[len(tokens)-1]
+
+	switch node := parent.(type) {
+	case map[string]interface{}:
+		value, ok := node[last]
+		if !ok {
+			return nil, nil, fmt.Errorf("path member %q not found", last)
+		}
+		delete(node, last)
+		return doc, value, nil
+	case []interface{}:
+		idx, err := arrayIndex(last, len(node)-1)
+		if err != nil {
+			return nil, nil, err
+		}
+		value := node[idx]
+		node = append(node[:idx:idx], node[idx+1:]...)
+		doc, err = setValue(doc, tokens[:len(tokens)-1], node)
+		return doc, value, err
+	default:
+		return nil, nil, fmt.Errorf("cannot remove %q from a non-container value", last)
+	}
+}
+
+// setValue replaces the value at tokens, which must already exist
+func setValue(doc interface{}, tokens []string, value interface{}) (interface{}, error) {
+	if len(tokens) == 0 {
+		return value, nil
+	}
+
+	parent, err := getValue(doc, tokens[:len(tokens)-1])
+	if err != nil {
+		return nil, err
+	}
+	last := tokens[len(tokens)-1]
+
+	switch node := parent.(type) {
+	case map[string]interface{}:
+		node[last] = value
+	case []interface{}:
+		idx, err := arrayIndex(last, len(node)-1)
+		if err != nil {
+			return nil, err
+		}
+		node[idx] = value
+	}
+	return doc, nil
+}
+
+func arrayIndex(token string, max int) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	idx, err := strconv.Atoi(token)
+	if err != nil || idx < 0 || idx > max {
+		return 0, fmt.Errorf("array index %q out of range", token)
+	}
+	return idx, nil
+}
+
+// cloneValue deep-copies a patch value into the generic JSON representation
+func cloneValue(value interface{}) interface{} {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+	var out interface{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return value
+	}
+	return out
+}
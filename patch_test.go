@@ -0,0 +1,101 @@
+package excelmetadata_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/prongbang/excelmetadata"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestDiffAndApplyPatch(t *testing.T) {
+	base := extract(t, newWorkbook(t, func(f *excelize.File) {
+		_ = f.SetCellValue("Sheet1", "A1", "Name")
+		_ = f.SetCellValue("Sheet1", "B1", "Amount")
+		_ = f.SetCellValue("Sheet1", "A2", "Apple")
+		_ = f.SetCellValue("Sheet1", "B2", 10)
+	}))
+	target := extract(t, newWorkbook(t, func(f *excelize.File) {
+		_, _ = f.NewSheet("Summary")
+		_ = f.SetCellValue("Sheet1", "A1", "Name")
+		_ = f.SetCellValue("Sheet1", "B1", "Total")
+		_ = f.SetCellValue("Sheet1", "A3", "Pear")
+		_ = f.SetCellFormula("Summary", "A1", "SUM(Sheet1!B:B)")
+		_ = f.MergeCell("Sheet1", "C1", "D1")
+	}))
+
+	patch, err := excelmetadata.Diff(base, target)
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+
+	paths := map[string]string{}
+	for _, op := range patch {
+		paths[op.Path] = op.Op
+	}
+	for path, op := range map[string]string{
+		"/sheets/Sheet1/cells/B1/value": excelmetadata.PatchOpReplace,
+		"/sheets/Sheet1/cells/A2":       excelmetadata.PatchOpRemove,
+		"/sheets/Sheet1/cells/A3":       excelmetadata.PatchOpAdd,
+		"/sheets/Summary":               excelmetadata.PatchOpAdd,
+	} {
+		if paths[path] != op {
+			t.Errorf("expected %s %s in patch, got %q", op, path, paths[path])
+		}
+	}
+
+	// The patch must survive a JSON round trip
+	data, err := json.Marshal(patch)
+	if err != nil {
+		t.Fatalf("failed to marshal patch: %v", err)
+	}
+	patch, err = excelmetadata.ParsePatch(data)
+	if err != nil {
+		t.Fatalf("ParsePatch() error = %v", err)
+	}
+
+	patched, err := excelmetadata.ApplyPatch(base, patch)
+	if err != nil {
+		t.Fatalf("ApplyPatch() error = %v", err)
+	}
+
+	got, _ := json.Marshal(patched)
+	want, _ := json.Marshal(target)
+	if string(got) != string(want) {
+		t.Errorf("patched metadata differs from target\ngot:  %s\nwant: %s", got, want)
+	}
+}
+
+func TestApplyPatchOperations(t *testing.T) {
+	base := extract(t, newWorkbook(t, func(f *excelize.File) {
+		_ = f.SetCellValue("Sheet1", "A1", "one")
+	}))
+
+	patch := excelmetadata.Patch{
+		{Op: excelmetadata.PatchOpTest, Path: "/sheets/Sheet1/cells/A1/value", Value: "one"},
+		{Op: excelmetadata.PatchOpCopy, From: "/sheets/Sheet1/cells/A1", Path: "/sheets/Sheet1/cells/C3"},
+		{Op: excelmetadata.PatchOpReplace, Path: "/sheets/Sheet1/cells/C3/address", Value: "C3"},
+		{Op: excelmetadata.PatchOpMove, From: "/sheets/Sheet1/cells/A1", Path: "/sheets/Sheet1/cells/B2"},
+		{Op: excelmetadata.PatchOpReplace, Path: "/sheets/Sheet1/cells/B2/address", Value: "B2"},
+	}
+	patched, err := excelmetadata.ApplyPatch(base, patch)
+	if err != nil {
+		t.Fatalf("ApplyPatch() error = %v", err)
+	}
+
+	var addresses []string
+	for _, cell := range patched.Sheets[0].Cells {
+		addresses = append(addresses, cell.Address)
+	}
+	if want := []string{"B2", "C3"}; !reflect.DeepEqual(addresses, want) {
+		t.Errorf("cells = %v, want %v", addresses, want)
+	}
+
+	failing := excelmetadata.Patch{
+		{Op: excelmetadata.PatchOpTest, Path: "/sheets/Sheet1/cells/A1/value", Value: "two"},
+	}
+	if _, err := excelmetadata.ApplyPatch(base, failing); err == nil {
+		t.Error("expected failing test operation to return an error")
+	}
+}
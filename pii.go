@@ -0,0 +1,485 @@
+package excelmetadata
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Classes of personal data and secrets
+const (
+	PIIEmail      = "email"
+	PIIPhone      = "phone"
+	PIINationalID = "nationalId"
+	PIICreditCard = "creditCard"
+	PIIAPIKey     = "apiKey"
+	PIIPassword   = "password"
+	PIIPath       = "path"
+	PIIName       = "name"
+)
+
+// Redaction policies
+const (
+	RedactMask = "mask"
+	RedactHash = "hash"
+	RedactDrop = "drop"
+)
+
+// PIIMatch is a piece of personal data or a secret found in a text
+type PIIMatch struct {
+	Class      string
+	Start, End int
+}
+
+// PIIFinding locates personal data found in metadata. The value itself is
+// never part of a finding.
+type PIIFinding struct {
+	// Sheet is empty for document properties
+	Sheet string `json:"sheet,omitempty"`
+	// Ref is the cell, the merged range or the property name
+	Ref string `json:"ref,omitempty"`
+	// Field is value, rawValue, cachedValue, formula, hyperlink, comment,
+	// author, mergedCell, validation, definedName, password, property,
+	// calculation or security
+	Field string `json:"field"`
+	Class string `json:"class"`
+}
+
+// RedactionReport lists what RedactMetadata redacted
+type RedactionReport struct {
+	Policy   string         `json:"policy"`
+	Counts   map[string]int `json:"counts,omitempty"`
+	Redacted []PIIFinding   `json:"redacted,omitempty"`
+}
+
+type piiPattern struct {
+	class string
+	re    *regexp.Regexp
+	valid func(match string) bool
+}
+
+// piiPatterns are tried in order, a match hides later matches it overlaps
+var piiPatterns = []piiPattern{
+	{PIIAPIKey, regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`), nil},
+	{PIIAPIKey, regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`), nil},
+	{PIIAPIKey, regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,}\b`), nil},
+	{PIIAPIKey, regexp.MustCompile(`\bxox[abprs]-[A-Za-z0-9-]{10,}`), nil},
+	{PIIAPIKey, regexp.MustCompile(`\b[rs]k_(?:live|test)_[A-Za-z0-9]{16,}\b`), nil},
+	{PIIAPIKey, regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}`), nil},
+	{PIIAPIKey, regexp.MustCompile(`\bsk-[A-Za-z0-9_-]{20,}`), nil},
+	{PIIAPIKey, regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{8,}\.[A-Za-z0-9_-]{8,}\.[A-Za-z0-9_-]{8,}`), nil},
+	// UNC paths and the user folders of Windows, macOS and Linux. A
+	// pattern with a group matches its context too, the group is the match.
+	{PIIPath, regexp.MustCompile(`\\\\[^\\/\s"'<>|]+\\[^\\/\s"'<>|\]]+`), nil},
+	{PIIPath, regexp.MustCompile(`(?i)\b[a-z]:[\\/](?:users|documents and settings)[\\/][^\\/\s"'<>|\]]+`), nil},
+	{PIIPath, regexp.MustCompile(`(?:^|[^\w./:-]|file://)(/(?:Users|home)/[^/\s"'<>|\]]+)`), nil},
+	{PIIEmail, regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}`), nil},
+	{PIICreditCard, regexp.MustCompile(`\b\d(?:[ -]?\d){12,18}\b`), luhnValid},
+	// US social security numbers, UK national insurance numbers and Thai
+	// national ID numbers
+	{PIINationalID, regexp.MustCompile(`\b(?:00[1-9]|0[1-9]\d|[1-578]\d\d|6(?:[0-57-9]\d|6[0-57-9]))-\d{2}-\d{4}\b`), nil},
+	{PIINationalID, regexp.MustCompile(`\b[A-CEGHJ-PR-TW-Z][A-CEGHJ-NPR-TW-Z] ?\d{2} ?\d{2} ?\d{2} ?[A-D]\b`), nil},
+	{PIINationalID, regexp.MustCompile(`\b\d[ -]?\d{4}[ -]?\d{5}[ -]?\d{2}[ -]?\d\b`), thaiIDValid},
+	{PIIPhone, regexp.MustCompile(`(?:\+\d{1,3}[ .-]?)?(?:\(\d{1,4}\)[ .-]?)?\d{2,4}(?:[ .-]?\d{2,4}){1,4}`), phoneLike},
+}
+
+// DetectPII finds email addresses, phone numbers, national ID numbers,
+// credit card numbers, API keys and user or network paths in a text, in
+// order of position
+func DetectPII(text string) []PIIMatch {
+	var matches []PIIMatch
+	taken := func(start, end int) bool {
+		for _, m := range matches {
+			if start < m.End && end > m.Start {
+				return true
+			}
+		}
+		return false
+	}
+	for _, p := range piiPatterns {
+		for _, loc := range p.re.FindAllStringSubmatchIndex(text, -1) {
+			if len(loc) > 2 {
+				loc = loc[2:]
+			}
+			if taken(loc[0], loc[1]) || p.valid != nil && !p.valid(text[loc[0]:loc[1]]) {
+				continue
+			}
+			matches = append(matches, PIIMatch{Class: p.class, Start: loc[0], End: loc[1]})
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].Start < matches[j].Start })
+	return matches
+}
+
+func digitsOf(s string) []int {
+	var digits []int
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			digits = append(digits, int(r-'0'))
+		}
+	}
+	return digits
+}
+
+// luhnValid checks the Luhn checksum of a card number
+func luhnValid(s string) bool {
+	digits := digitsOf(s)
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+	sum := 0
+	for i := range digits {
+		d := digits[len(digits)-1-i]
+		if i%2 == 1 {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// thaiIDValid checks the check digit of a 13 digit Thai national ID
+func thaiIDValid(s string) bool {
+	digits := digitsOf(s)
+	if len(digits) != 13 {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 12; i++ {
+		sum += digits[i] * (13 - i)
+	}
+	return (11-sum%11)%10 == digits[12]
+}
+
+// phoneLike accepts numbers of 9 to 15 digits written the way phone numbers
+// are: with a country code, an area code in parentheses, a leading zero or
+// grouped as 3-3-4 digits. Dates and plain amounts are not phone numbers.
+func phoneLike(s string) bool {
+	digits := digitsOf(s)
+	if len(digits) < 9 || len(digits) > 15 {
+		return false
+	}
+	return strings.HasPrefix(s, "+") || strings.HasPrefix(s, "(") || strings.Contains(s, "(") ||
+		strings.HasPrefix(s, "0") || usPhone.MatchString(s)
+}
+
+var usPhone = regexp.MustCompile(`^\d{3}[ .-]\d{3}[ .-]\d{4}$`)
+
+// textField is a text of the metadata that may hold personal data
+type textField struct {
+	location PIIFinding
+	get      func() string
+	set      func(string)
+	// follows is the index of the field this one repeats in another form,
+	// or -1. A field is redacted as a whole when the field it follows
+	// holds personal data.
+	follows int
+	// name is set for fields holding the name of a person, such as an
+	// author, redacted as a whole when nothing else is found in them
+	name bool
+}
+
+// fieldMatches detects the personal data of every field
+func fieldMatches(fields []textField) [][]PIIMatch {
+	matches := make([][]PIIMatch, len(fields))
+	for i, field := range fields {
+		text := field.get()
+		matches[i] = DetectPII(text)
+		if len(matches[i]) > 0 || text == "" {
+			continue
+		}
+		if field.name {
+			matches[i] = []PIIMatch{{Class: PIIName, Start: 0, End: len(text)}}
+			continue
+		}
+		if field.follows < 0 {
+			continue
+		}
+		for _, m := range matches[field.follows] {
+			matches[i] = append(matches[i], PIIMatch{Class: m.Class, Start: 0, End: len(text)})
+		}
+	}
+	return matches
+}
+
+// textFields lists the texts of the metadata that are scanned and redacted
+func textFields(metadata *Metadata) []textField {
+	var fields []textField
+	add := func(sheet, ref, field string, text *string) {
+		fields = append(fields, textField{
+			location: PIIFinding{Sheet: sheet, Ref: ref, Field: field},
+			get:      func() string { return *text },
+			set:      func(s string) { *text = s },
+			follows:  -1,
+		})
+	}
+
+	props := &metadata.Properties
+	for _, p := range []struct {
+		name string
+		text *string
+	}{
+		{"title", &props.Title}, {"subject", &props.Subject}, {"creator", &props.Creator},
+		{"keywords", &props.Keywords}, {"description", &props.Description},
+		{"lastModifiedBy", &props.LastModifiedBy}, {"category", &props.Category},
+	} {
+		add("", p.name, "property", p.text)
+		fields[len(fields)-1].name = p.name == "creator" || p.name == "lastModifiedBy"
+	}
+	for i := range metadata.DefinedNames {
+		dn := &metadata.DefinedNames[i]
+		scope := dn.Scope
+		if scope == "Workbook" {
+			scope = ""
+		}
+		add(scope, dn.Name, "definedName", &dn.RefersTo)
+	}
+
+	for i := range metadata.Sheets {
+		sheet := &metadata.Sheets[i]
+		for j := range sheet.Cells {
+			cell := &sheet.Cells[j]
+			value := len(fields)
+			fields = append(fields, textField{
+				location: PIIFinding{Sheet: sheet.Name, Ref: cell.Address, Field: "value"},
+				get: func() string {
+					s, _ := cell.Value.(string)
+					return s
+				},
+				set: func(s string) {
+					cell.Value = s
+					if s == "" {
+						cell.Value = nil
+					}
+				},
+				follows: -1,
+			})
+			// The raw value and the cached result of a formula hold the same
+			// data as the formatted value, unformatted: 123456789 shown as
+			// 123-45-6789 is only recognised through the formatted value
+			add(sheet.Name, cell.Address, "rawValue", &cell.RawValue)
+			fields[len(fields)-1].follows = value
+			if cell.FormulaInfo != nil {
+				add(sheet.Name, cell.Address, "cachedValue", &cell.FormulaInfo.CachedValue)
+				fields[len(fields)-1].follows = value
+			}
+			add(sheet.Name, cell.Address, "formula", &cell.Formula)
+			if cell.Hyperlink != nil {
+				add(sheet.Name, cell.Address, "hyperlink", &cell.Hyperlink.Link)
+			}
+		}
+		for j := range sheet.MergedCells {
+			mc := &sheet.MergedCells[j]
+			add(sheet.Name, mc.StartCell+":"+mc.EndCell, "mergedCell", &mc.Value)
+		}
+		for j := range sheet.Comments {
+			comment := &sheet.Comments[j]
+			add(sheet.Name, comment.Cell, "author", &comment.Author)
+			fields[len(fields)-1].name = true
+			add(sheet.Name, comment.Cell, "comment", &comment.Text)
+		}
+		for j := range sheet.DataValidations {
+			dv := &sheet.DataValidations[j]
+			add(sheet.Name, dv.Range, "validation", &dv.Formula1)
+			add(sheet.Name, dv.Range, "validation", &dv.Formula2)
+			for _, text := range []*string{dv.ErrorTitle, dv.ErrorMessage} {
+				if text != nil {
+					add(sheet.Name, dv.Range, "validation", text)
+				}
+			}
+		}
+	}
+
+	if report := metadata.Calculation; report != nil {
+		for _, issues := range [][]CalcIssue{report.Stale, report.Unevaluable} {
+			for i := range issues {
+				issue := &issues[i]
+				add(issue.Sheet, issue.Cell, "calculation", &issue.Formula)
+				add(issue.Sheet, issue.Cell, "calculation", &issue.Cached)
+				add(issue.Sheet, issue.Cell, "calculation", &issue.Calculated)
+			}
+		}
+	}
+	if report := metadata.Security; report != nil {
+		for _, cells := range [][]SecurityCell{report.DDEFormulas, report.Hyperlinks} {
+			for i := range cells {
+				add(cells[i].Sheet, cells[i].Cell, "security", &cells[i].Target)
+			}
+		}
+		for i := range report.ExternalLinks {
+			add("", report.ExternalLinks[i].Part, "security", &report.ExternalLinks[i].Target)
+		}
+		for i := range report.OLEObjects {
+			obj := &report.OLEObjects[i]
+			add(obj.Sheet, obj.Part, "security", &obj.Target)
+			add(obj.Sheet, obj.Part, "security", &obj.Filename)
+		}
+	}
+	return fields
+}
+
+// ScanPII classifies the cell values, formulas, hyperlinks, comments and
+// their authors, data validations, defined names, external link targets and
+// document properties of metadata, reporting each class once per field.
+// Sheet protection passwords are reported as PIIPassword.
+func ScanPII(metadata *Metadata) []PIIFinding {
+	var findings []PIIFinding
+	fields := textFields(metadata)
+	for i, matches := range fieldMatches(fields) {
+		findings = append(findings, fieldFindings(fields[i], matches)...)
+	}
+	for _, sheet := range metadata.Sheets {
+		if p := sheet.Protection; p != nil && p.Password != "" {
+			findings = append(findings, PIIFinding{Sheet: sheet.Name, Field: "password", Class: PIIPassword})
+		}
+	}
+	return findings
+}
+
+func fieldFindings(field textField, matches []PIIMatch) []PIIFinding {
+	var findings []PIIFinding
+	seen := make(map[string]bool)
+	for _, m := range matches {
+		if !seen[m.Class] {
+			seen[m.Class] = true
+			finding := field.location
+			finding.Class = m.Class
+			findings = append(findings, finding)
+		}
+	}
+	return findings
+}
+
+// RedactMetadata rewrites the personal data and secrets found by ScanPII
+// and the sheet protection passwords according to policy: RedactMask keeps
+// separators and the last four characters of a match, RedactHash replaces
+// it with an HMAC-SHA256 under key so equal values still compare equal, and
+// RedactDrop empties the whole field. An empty key uses a random key drawn
+// once per process. The report is stored in Metadata.Redactions as well.
+func RedactMetadata(metadata *Metadata, policy string, key []byte) (*RedactionReport, error) {
+	if err := checkRedactPolicy(policy); err != nil {
+		return nil, err
+	}
+	policy = strings.ToLower(policy)
+	if len(key) == 0 {
+		var err error
+		if key, err = processRedactKey(); err != nil {
+			return nil, err
+		}
+	}
+	redact := func(s, class string) string { return redactText(s, class, policy, key) }
+
+	report := &RedactionReport{Policy: policy, Counts: map[string]int{}}
+	record := func(findings ...PIIFinding) {
+		for _, f := range findings {
+			report.Counts[f.Class]++
+			report.Redacted = append(report.Redacted, f)
+		}
+	}
+
+	fields := textFields(metadata)
+	for i, matches := range fieldMatches(fields) {
+		field, text := fields[i], fields[i].get()
+		if len(matches) == 0 {
+			continue
+		}
+		record(fieldFindings(field, matches)...)
+		if policy == RedactDrop {
+			field.set("")
+			continue
+		}
+		var sb strings.Builder
+		last := 0
+		for _, m := range matches {
+			if m.Start < last {
+				continue
+			}
+			sb.WriteString(text[last:m.Start])
+			sb.WriteString(redact(text[m.Start:m.End], m.Class))
+			last = m.End
+		}
+		sb.WriteString(text[last:])
+		field.set(sb.String())
+	}
+
+	for _, sheet := range metadata.Sheets {
+		if p := sheet.Protection; p != nil && p.Password != "" {
+			record(PIIFinding{Sheet: sheet.Name, Field: "password", Class: PIIPassword})
+			switch policy {
+			case RedactMask:
+				p.Password = "********"
+			case RedactHash:
+				p.Password = redact(p.Password, PIIPassword)
+			default:
+				p.Password = ""
+			}
+		}
+	}
+
+	if len(report.Counts) == 0 {
+		report.Counts = nil
+	}
+	metadata.Redactions = report
+	return report, nil
+}
+
+// checkRedactPolicy validates a redaction policy
+func checkRedactPolicy(policy string) error {
+	switch strings.ToLower(policy) {
+	case RedactMask, RedactHash, RedactDrop:
+		return nil
+	default:
+		return fmt.Errorf("unknown redaction policy %q", policy)
+	}
+}
+
+var (
+	redactKey     []byte
+	redactKeyErr  error
+	redactKeyOnce sync.Once
+)
+
+// processRedactKey returns the random HMAC key used when none is given.
+// Hashes made with it cannot be brute-forced or compared across runs.
+func processRedactKey() ([]byte, error) {
+	redactKeyOnce.Do(func() {
+		redactKey = make([]byte, 32)
+		if _, err := rand.Read(redactKey); err != nil {
+			redactKeyErr = fmt.Errorf("failed to generate redaction key: %w", err)
+		}
+	})
+	return redactKey, redactKeyErr
+}
+
+// redactText masks or hashes a match. Hashes are keyed because the values
+// redacted, such as ID numbers and phone numbers, are few enough to be
+// recovered from a plain hash by trying them all. Masks keep the last four
+// characters of longer matches, but nothing of a name or path.
+func redactText(s, class, policy string, key []byte) string {
+	if policy == RedactHash {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(s))
+		return "hmac:" + hex.EncodeToString(mac.Sum(nil)[:16])
+	}
+
+	runes := []rune(s)
+	keep := 4
+	if len(runes) < 8 || class == PIIName || class == PIIPath {
+		keep = 0
+	}
+	for i, r := range runes[:len(runes)-keep] {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			runes[i] = '*'
+		}
+	}
+	return string(runes)
+}
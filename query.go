@@ -0,0 +1,550 @@
+package excelmetadata
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Node kinds returned by Query
+const (
+	QuerySheet       = "sheet"
+	QueryCell        = "cell"
+	QueryStyle       = "style"
+	QueryMerged      = "merged"
+	QueryValidation  = "validation"
+	QueryImage       = "image"
+	QueryTable       = "table"
+	QueryDefinedName = "name"
+	QueryProperties  = "properties"
+)
+
+// QueryResult is a node matched by Query
+type QueryResult struct {
+	Kind    string      `json:"kind"`
+	Sheet   string      `json:"sheet,omitempty"`
+	Address string      `json:"address,omitempty"`
+	Node    interface{} `json:"node"`
+}
+
+// Query selects nodes of metadata with a selector expression. An
+// expression is a dot-separated list of steps, each a node kind followed
+// by optional [conditions]:
+//
+//	sheet[visible=false].cell[formula~="VLOOKUP"]
+//	cell[style.font.bold=true, value^="Total"]
+//	sheet[name="Data"].merged
+//	name[refersTo*="#REF!"]
+//
+// Kinds are sheet, cell, style, merged, validation, image, table, name
+// and properties; a plural form is accepted as well. At the start of an
+// expression a kind selects every node of the workbook, after a sheet it
+// selects the nodes of that sheet, and style after cell selects the style
+// of the cell. Conditions are separated by commas and must all hold.
+// Fields use the JSON names of the node, nested with dots; cells also have
+// sheet, row, col and style. Operators are = != < <= > >= ~= (regexp) !~
+// ^= (prefix) $= (suffix) and *= (contains); a field without an operator
+// must be non-empty. Values are bare words or quoted strings.
+func Query(metadata *Metadata, expr string) ([]QueryResult, error) {
+	steps, err := parseQuery(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := []*queryNode{{kind: "", meta: metadata}}
+	for _, step := range steps {
+		var next []*queryNode
+		for _, node := range nodes {
+			children, err := node.children(step.kind)
+			if err != nil {
+				return nil, err
+			}
+			for _, child := range children {
+				ok, err := step.matches(child)
+				if err != nil {
+					return nil, err
+				}
+				if ok {
+					next = append(next, child)
+				}
+			}
+		}
+		nodes = next
+	}
+
+	results := make([]QueryResult, 0, len(nodes))
+	for _, node := range nodes {
+		results = append(results, QueryResult{
+			Kind:    node.kind,
+			Sheet:   node.sheet,
+			Address: node.address,
+			Node:    node.value,
+		})
+	}
+	return results, nil
+}
+
+// queryNode is a node of the metadata tree being queried
+type queryNode struct {
+	kind    string
+	meta    *Metadata
+	sheet   string
+	address string
+	value   interface{}
+	extra   map[string]interface{}
+	fields  map[string]interface{}
+}
+
+var queryKinds = map[string]string{
+	"sheet": QuerySheet, "sheets": QuerySheet,
+	"cell": QueryCell, "cells": QueryCell,
+	"style": QueryStyle, "styles": QueryStyle,
+	"merged": QueryMerged, "mergedcells": QueryMerged,
+	"validation": QueryValidation, "validations": QueryValidation, "datavalidations": QueryValidation,
+	"image": QueryImage, "images": QueryImage,
+	"table": QueryTable, "tables": QueryTable,
+	"name": QueryDefinedName, "names": QueryDefinedName, "definednames": QueryDefinedName,
+	"properties": QueryProperties, "property": QueryProperties,
+}
+
+func (n *queryNode) children(kind string) ([]*queryNode, error) {
+	switch n.kind {
+	case "":
+		if kind == QueryStyle {
+			return styleNodes(n.meta), nil
+		}
+		if kind == QueryDefinedName {
+			return nameNodes(n.meta, nil), nil
+		}
+		if kind == QueryProperties {
+			return []*queryNode{{kind: QueryProperties, meta: n.meta, value: n.meta.Properties}}, nil
+		}
+		var nodes []*queryNode
+		for i := range n.meta.Sheets {
+			sheet := sheetNode(n.meta, &n.meta.Sheets[i])
+			if kind == QuerySheet {
+				nodes = append(nodes, sheet)
+				continue
+			}
+			children, err := sheet.children(kind)
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, children...)
+		}
+		return nodes, nil
+	case QuerySheet:
+		sheet := n.value.(SheetMetadata)
+		var nodes []*queryNode
+		switch kind {
+		case QueryCell:
+			for _, cell := range sheet.Cells {
+				col, row, _ := excelize.CellNameToCoordinates(cell.Address)
+				extra := map[string]interface{}{"sheet": sheet.Name, "row": row, "col": col}
+				if style, ok := n.meta.Styles[cell.StyleID]; ok && cell.StyleID != 0 {
+					extra["style"] = style
+				}
+				nodes = append(nodes, &queryNode{kind: QueryCell, meta: n.meta, sheet: sheet.Name, address: cell.Address, value: cell, extra: extra})
+			}
+		case QueryMerged:
+			for _, mc := range sheet.MergedCells {
+				nodes = append(nodes, &queryNode{kind: QueryMerged, meta: n.meta, sheet: sheet.Name, address: mc.StartCell + ":" + mc.EndCell, value: mc})
+			}
+		case QueryValidation:
+			for _, dv := range sheet.DataValidations {
+				nodes = append(nodes, &queryNode{kind: QueryValidation, meta: n.meta, sheet: sheet.Name, address: dv.Range, value: dv})
+			}
+		case QueryImage:
+			for _, img := range sheet.Images {
+				nodes = append(nodes, &queryNode{kind: QueryImage, meta: n.meta, sheet: sheet.Name, address: img.Cell, value: img})
+			}
+		case QueryTable:
+			for _, tbl := range sheet.Tables {
+				nodes = append(nodes, &queryNode{kind: QueryTable, meta: n.meta, sheet: sheet.Name, address: tbl.Range, value: tbl})
+			}
+		case QueryDefinedName:
+			return nameNodes(n.meta, &sheet.Name), nil
+		default:
+			return nil, fmt.Errorf("a sheet has no %s nodes", kind)
+		}
+		return nodes, nil
+	case QueryCell:
+		if kind != QueryStyle {
+			return nil, fmt.Errorf("a cell has no %s nodes", kind)
+		}
+		cell := n.value.(CellMetadata)
+		style, ok := n.meta.Styles[cell.StyleID]
+		if !ok || cell.StyleID == 0 {
+			return nil, nil
+		}
+		return []*queryNode{{
+			kind: QueryStyle, meta: n.meta, sheet: n.sheet, address: n.address, value: style,
+			extra: map[string]interface{}{"id": cell.StyleID},
+		}}, nil
+	}
+	return nil, fmt.Errorf("a %s has no %s nodes", n.kind, kind)
+}
+
+func sheetNode(meta *Metadata, sheet *SheetMetadata) *queryNode {
+	return &queryNode{kind: QuerySheet, meta: meta, sheet: sheet.Name, value: *sheet}
+}
+
+func styleNodes(meta *Metadata) []*queryNode {
+	ids := make([]int, 0, len(meta.Styles))
+	for id := range meta.Styles {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	nodes := make([]*queryNode, 0, len(ids))
+	for _, id := range ids {
+		nodes = append(nodes, &queryNode{
+			kind: QueryStyle, meta: meta, value: meta.Styles[id],
+			extra: map[string]interface{}{"id": id},
+		})
+	}
+	return nodes
+}
+
+// nameNodes returns the defined names, only those scoped to a sheet when
+// scope is set
+func nameNodes(meta *Metadata, scope *string) []*queryNode {
+	var nodes []*queryNode
+	for _, dn := range meta.DefinedNames {
+		if scope != nil && dn.Scope != *scope {
+			continue
+		}
+		node := &queryNode{kind: QueryDefinedName, meta: meta, value: dn}
+		if dn.Scope != "Workbook" {
+			node.sheet = dn.Scope
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// field resolves a dotted path over the JSON form of the node
+func (n *queryNode) field(path []string) (interface{}, error) {
+	if n.fields == nil {
+		value := n.value
+		if sheet, ok := value.(SheetMetadata); ok {
+			// Cells and images are reached through their own steps
+			sheet.Cells, sheet.Images = nil, nil
+			value = sheet
+		}
+		fields, err := jsonFields(value)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range n.extra {
+			if style, ok := v.(StyleDetails); ok {
+				if v, err = jsonFields(style); err != nil {
+					return nil, err
+				}
+			}
+			fields[k] = v
+		}
+		n.fields = fields
+	}
+
+	var current interface{} = n.fields
+	for _, key := range path {
+		obj, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, nil
+		}
+		current = obj[key]
+	}
+	return current, nil
+}
+
+// jsonFields decodes the JSON object form of v, keeping numbers exact
+func jsonFields(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// queryStep is one kind[conditions] step of an expression
+type queryStep struct {
+	kind       string
+	conditions []queryCondition
+}
+
+type queryCondition struct {
+	field []string
+	op    string
+	value string
+	re    *regexp.Regexp
+}
+
+func (s queryStep) matches(node *queryNode) (bool, error) {
+	for _, cond := range s.conditions {
+		actual, err := node.field(cond.field)
+		if err != nil {
+			return false, err
+		}
+		if !cond.matches(actual) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (c queryCondition) matches(actual interface{}) bool {
+	text := queryText(actual)
+	switch c.op {
+	case "":
+		return text != "" && text != "false"
+	case "~=":
+		return c.re.MatchString(text)
+	case "!~":
+		return !c.re.MatchString(text)
+	case "^=":
+		return strings.HasPrefix(text, c.value)
+	case "$=":
+		return strings.HasSuffix(text, c.value)
+	case "*=":
+		return strings.Contains(text, c.value)
+	}
+
+	cmp, ok := compareQueryValues(actual, text, c.value)
+	if !ok {
+		return c.op == "!="
+	}
+	switch c.op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	}
+	return false
+}
+
+// compareQueryValues compares numerically when the literal is a number and
+// as text otherwise. Missing values equal false, 0 and "". It reports false
+// when the values cannot be compared.
+func compareQueryValues(actual interface{}, text, literal string) (int, bool) {
+	if literal == "true" || literal == "false" {
+		if actual == nil {
+			text = "false"
+		}
+		return strings.Compare(text, literal), true
+	}
+	if want, err := strconv.ParseFloat(literal, 64); err == nil {
+		if actual == nil {
+			text = "0"
+		}
+		got, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return 0, false
+		}
+		switch {
+		case got < want:
+			return -1, true
+		case got > want:
+			return 1, true
+		}
+		return 0, true
+	}
+	if _, ok := actual.(map[string]interface{}); ok {
+		return 0, false
+	}
+	return strings.Compare(text, literal), true
+}
+
+func queryText(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	case []interface{}, map[string]interface{}:
+		data, _ := json.Marshal(v)
+		return string(data)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Parsing
+
+var queryOps = []string{"!=", "<=", ">=", "~=", "!~", "^=", "$=", "*=", "=", "<", ">"}
+
+func parseQuery(expr string) ([]queryStep, error) {
+	p := &queryParser{src: expr}
+	var steps []queryStep
+	for {
+		p.skipSpace()
+		name := p.ident()
+		if name == "" {
+			return nil, p.errorf("expected a node kind")
+		}
+		kind, ok := queryKinds[strings.ToLower(name)]
+		if !ok {
+			return nil, p.errorf("unknown node kind %q", name)
+		}
+		step := queryStep{kind: kind}
+
+		for p.skipSpace(); p.peek() == '['; p.skipSpace() {
+			p.pos++
+			for {
+				cond, err := p.condition()
+				if err != nil {
+					return nil, err
+				}
+				step.conditions = append(step.conditions, cond)
+				p.skipSpace()
+				if p.peek() == ',' {
+					p.pos++
+					continue
+				}
+				if p.peek() != ']' {
+					return nil, p.errorf("expected , or ]")
+				}
+				p.pos++
+				break
+			}
+		}
+		steps = append(steps, step)
+
+		if p.pos >= len(p.src) {
+			return steps, nil
+		}
+		if p.peek() != '.' {
+			return nil, p.errorf("expected .")
+		}
+		p.pos++
+	}
+}
+
+type queryParser struct {
+	src string
+	pos int
+}
+
+func (p *queryParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("query %q at offset %d: %s", p.src, p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *queryParser) peek() byte {
+	if p.pos >= len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *queryParser) skipSpace() {
+	for p.pos < len(p.src) && unicode.IsSpace(rune(p.src[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *queryParser) ident() string {
+	start := p.pos
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		if c != '_' && !('a' <= c && c <= 'z') && !('A' <= c && c <= 'Z') && !('0' <= c && c <= '9') {
+			break
+		}
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
+
+func (p *queryParser) condition() (queryCondition, error) {
+	var cond queryCondition
+	for {
+		p.skipSpace()
+		name := p.ident()
+		if name == "" {
+			return cond, p.errorf("expected a field name")
+		}
+		cond.field = append(cond.field, name)
+		if p.peek() != '.' {
+			break
+		}
+		p.pos++
+	}
+
+	p.skipSpace()
+	for _, op := range queryOps {
+		if strings.HasPrefix(p.src[p.pos:], op) {
+			cond.op = op
+			p.pos += len(op)
+			break
+		}
+	}
+	if cond.op == "" {
+		return cond, nil
+	}
+
+	value, err := p.value()
+	if err != nil {
+		return cond, err
+	}
+	cond.value = value
+	if cond.op == "~=" || cond.op == "!~" {
+		if cond.re, err = regexp.Compile(value); err != nil {
+			return cond, p.errorf("invalid regexp: %v", err)
+		}
+	}
+	return cond, nil
+}
+
+func (p *queryParser) value() (string, error) {
+	p.skipSpace()
+	quote := p.peek()
+	if quote != '"' && quote != '\'' {
+		start := p.pos
+		for p.pos < len(p.src) && p.src[p.pos] != ',' && p.src[p.pos] != ']' {
+			p.pos++
+		}
+		return strings.TrimSpace(p.src[start:p.pos]), nil
+	}
+
+	p.pos++
+	var sb strings.Builder
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		p.pos++
+		switch {
+		case c == '\\' && p.pos < len(p.src) && (p.src[p.pos] == quote || p.src[p.pos] == '\\'):
+			// Other backslashes are kept for regexp escapes such as \d
+			sb.WriteByte(p.src[p.pos])
+			p.pos++
+		case c == quote:
+			return sb.String(), nil
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return "", p.errorf("unterminated string")
+}
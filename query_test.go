@@ -0,0 +1,72 @@
+package excelmetadata_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/prongbang/excelmetadata"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestQuery(t *testing.T) {
+	metadata := extract(t, newWorkbook(t, func(f *excelize.File) {
+		bold, _ := f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+		_ = f.SetCellValue("Sheet1", "A1", "Total")
+		_ = f.SetCellStyle("Sheet1", "A1", "A1", bold)
+		_ = f.SetCellValue("Sheet1", "B1", 150)
+		_ = f.SetCellValue("Sheet1", "B2", 40)
+		_ = f.MergeCell("Sheet1", "C1", "D1")
+
+		_, _ = f.NewSheet("Lookup")
+		_ = f.SetCellValue("Lookup", "A1", 7)
+		_ = f.SetCellFormula("Lookup", "A1", `VLOOKUP("x",Sheet1!A1:B2,2,FALSE)`)
+		_ = f.SetCellValue("Lookup", "A2", "Total due")
+		_ = f.SetSheetVisible("Lookup", false)
+
+		_ = f.SetDefinedName(&excelize.DefinedName{Name: "Broken", RefersTo: "#REF!"})
+		_ = f.SetDefinedName(&excelize.DefinedName{Name: "Amounts", RefersTo: "Sheet1!$B$1:$B$2"})
+	}))
+
+	tests := []struct {
+		expr    string
+		want    []string
+		wantErr bool
+	}{
+		{`sheet[visible=false].cell[formula~="VLOOKUP"]`, []string{"cell Lookup!A1"}, false},
+		{`sheets[visible=true]`, []string{"sheet Sheet1!"}, false},
+		{`cell[value^="Total"]`, []string{"cell Sheet1!A1", "cell Lookup!A2"}, false},
+		{`cell[style.font.bold=true]`, []string{"cell Sheet1!A1"}, false},
+		{`cell[style.font.bold=false, sheet=Sheet1]`, []string{"cell Sheet1!B1", "cell Sheet1!B2"}, false},
+		{`cell[value>100]`, []string{"cell Sheet1!B1"}, false},
+		{`cell[col=2, row>=2]`, []string{"cell Sheet1!B2"}, false},
+		{`cell[formula]`, []string{"cell Lookup!A1"}, false},
+		{`sheet[name='Sheet1'].merged`, []string{"merged Sheet1!C1:D1"}, false},
+		{`sheet[dimensions.rowCount>=2][name!=Lookup]`, []string{"sheet Sheet1!"}, false},
+		{`name[refersTo*="#REF!"]`, []string{"name !"}, false},
+		{`cell[address=A1].style[font.bold]`, []string{"style Sheet1!A1"}, false},
+		{`cell[value~="(?i)^total DUE$"]`, []string{"cell Lookup!A2"}, false},
+		{`cell[value!~"\d"]`, []string{"cell Sheet1!A1", "cell Lookup!A2"}, false},
+		{"", nil, true},
+		{"row", nil, true},
+		{"cell[value=", nil, true},
+		{`cell[value="open]`, nil, true},
+		{`cell[value~="("]`, nil, true},
+		{"cell.sheet", nil, true},
+		{"sheet cell", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			results, err := excelmetadata.Query(metadata, tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Query() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var got []string
+			for _, r := range results {
+				got = append(got, r.Kind+" "+r.Sheet+"!"+r.Address)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Query() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
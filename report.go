@@ -0,0 +1,722 @@
+package excelmetadata
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"html"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// DefaultPreviewRows is the number of rows per sheet shown in reports when
+// ReportOptions.PreviewRows is zero
+const DefaultPreviewRows = 20
+
+// Report formats registered as encoders
+const (
+	FormatMarkdown = "markdown"
+	FormatHTML     = "html"
+)
+
+// ReportOptions configures MarshalMarkdown and MarshalHTML
+type ReportOptions struct {
+	// PreviewRows is the number of rows rendered in the preview grid of
+	// each sheet. Zero means DefaultPreviewRows, a negative value disables
+	// the preview.
+	PreviewRows int
+	// PreviewCols caps the columns of the preview grid, zero shows the
+	// whole used range
+	PreviewCols int
+}
+
+func init() {
+	RegisterEncoder(FormatMarkdown, []string{".md", ".markdown"}, EncoderFunc(encodeMarkdown))
+	RegisterEncoder(FormatHTML, []string{".html", ".htm"}, EncoderFunc(encodeHTML))
+}
+
+func encodeMarkdown(w io.Writer, metadata *Metadata, _ *EncodeOptions) error {
+	data, err := MarshalMarkdown(metadata, nil)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+func encodeHTML(w io.Writer, metadata *Metadata, _ *EncodeOptions) error {
+	data, err := MarshalHTML(metadata, nil)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// MarshalMarkdown renders metadata as a Markdown report: document
+// properties, sheets, defined names, validations, merged ranges, a preview
+// of the first rows of every sheet and embedded images
+func MarshalMarkdown(metadata *Metadata, options *ReportOptions) ([]byte, error) {
+	if metadata == nil {
+		return nil, fmt.Errorf("metadata is nil")
+	}
+	rows := previewRows(options)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# %s\n", mdEscape(reportTitle(metadata)))
+
+	if props := propertyFields(metadata.Properties); len(props) > 0 {
+		buf.WriteString("\n## Properties\n\n| Property | Value |\n| --- | --- |\n")
+		for _, p := range props {
+			fmt.Fprintf(&buf, "| %s | %s |\n", p.label, mdEscape(p.value))
+		}
+	}
+
+	buf.WriteString("\n## Sheets\n\n| # | Name | Visible | Used range | Rows | Columns | Cells | Merged | Validations | Images |\n")
+	buf.WriteString("| ---: | --- | --- | --- | ---: | ---: | ---: | ---: | ---: | ---: |\n")
+	for _, sheet := range metadata.Sheets {
+		fmt.Fprintf(&buf, "| %d | %s | %s | %s | %d | %d | %d | %d | %d | %d |\n",
+			sheet.Index, mdEscape(sheet.Name), yesNo(sheet.Visible), mdEscape(usedRange(sheet.Dimensions)),
+			sheet.Dimensions.RowCount, sheet.Dimensions.ColCount, len(sheet.Cells),
+			len(sheet.MergedCells), len(sheet.DataValidations), len(sheet.Images))
+	}
+
+	if len(metadata.DefinedNames) > 0 {
+		buf.WriteString("\n## Defined Names\n\n| Name | Scope | Refers to |\n| --- | --- | --- |\n")
+		for _, dn := range metadata.DefinedNames {
+			fmt.Fprintf(&buf, "| %s | %s | `%s` |\n", mdEscape(dn.Name), mdEscape(definedNameScope(dn)), mdCode(dn.RefersTo))
+		}
+	}
+
+	if hasValidations(metadata) {
+		buf.WriteString("\n## Data Validations\n\n| Sheet | Range | Type | Operator | Formula 1 | Formula 2 | Error message |\n")
+		buf.WriteString("| --- | --- | --- | --- | --- | --- | --- |\n")
+		for _, sheet := range metadata.Sheets {
+			for _, dv := range sheet.DataValidations {
+				fmt.Fprintf(&buf, "| %s | %s | %s | %s | %s | %s | %s |\n",
+					mdEscape(sheet.Name), mdEscape(dv.Range), mdEscape(dv.Type), mdEscape(dv.Operator),
+					mdEscape(dv.Formula1), mdEscape(dv.Formula2), mdEscape(stringValue(dv.ErrorMessage)))
+			}
+		}
+	}
+
+	if hasMergedCells(metadata) {
+		buf.WriteString("\n## Merged Ranges\n\n| Sheet | Range | Value |\n| --- | --- | --- |\n")
+		for _, sheet := range metadata.Sheets {
+			for _, mc := range sheet.MergedCells {
+				fmt.Fprintf(&buf, "| %s | %s:%s | %s |\n", mdEscape(sheet.Name), mdEscape(mc.StartCell), mdEscape(mc.EndCell), mdEscape(mc.Value))
+			}
+		}
+	}
+
+	for _, sheet := range metadata.Sheets {
+		grid := newPreviewGrid(sheet, rows, previewCols(options))
+		if len(grid.rows) == 0 && len(sheet.Images) == 0 {
+			continue
+		}
+		fmt.Fprintf(&buf, "\n## Preview: %s\n", mdEscape(sheet.Name))
+
+		if len(grid.rows) > 0 {
+			buf.WriteString("\n|   |")
+			for _, col := range grid.cols {
+				fmt.Fprintf(&buf, " %s |", col)
+			}
+			buf.WriteString("\n| ---: |")
+			buf.WriteString(strings.Repeat(" --- |", len(grid.cols)))
+			buf.WriteString("\n")
+			for _, row := range grid.rows {
+				fmt.Fprintf(&buf, "| %d |", row)
+				for _, col := range grid.cols {
+					cell, ok := grid.cell(col, row)
+					fmt.Fprintf(&buf, " %s |", mdCell(cell, ok, metadata.Styles))
+				}
+				buf.WriteString("\n")
+			}
+			if grid.truncated {
+				fmt.Fprintf(&buf, "\n_Showing %d of %d rows._\n", len(grid.rows), sheet.Dimensions.RowCount)
+			}
+		}
+
+		for _, img := range sheet.Images {
+			alt := fmt.Sprintf("%s!%s", sheet.Name, img.Cell)
+			if mime := imageMIMEType(img.Extension); mime != "" {
+				fmt.Fprintf(&buf, "\n![%s](data:%s;base64,%s)\n", mdEscape(alt), mime, base64.StdEncoding.EncodeToString(img.File))
+			} else {
+				fmt.Fprintf(&buf, "\n_Image %s (%s, %d bytes)_\n", mdEscape(alt), mdEscape(img.Extension), len(img.File))
+			}
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// MarshalHTML renders metadata as a standalone HTML page with the same
+// sections as MarshalMarkdown. Preview cells carry their workbook styles
+// and merged ranges span rows and columns.
+func MarshalHTML(metadata *Metadata, options *ReportOptions) ([]byte, error) {
+	if metadata == nil {
+		return nil, fmt.Errorf("metadata is nil")
+	}
+	rows := previewRows(options)
+	esc := html.EscapeString
+
+	var buf bytes.Buffer
+	title := esc(reportTitle(metadata))
+	fmt.Fprintf(&buf, "<!DOCTYPE html>\n<html lang=\"en\">\n<head>\n<meta charset=\"utf-8\">\n<title>%s</title>\n<style>\n%s%s</style>\n</head>\n<body>\n<h1>%s</h1>\n", title, reportCSS, styleClasses(metadata.Styles), title)
+
+	if props := propertyFields(metadata.Properties); len(props) > 0 {
+		buf.WriteString("<h2>Properties</h2>\n<table>\n<tr><th>Property</th><th>Value</th></tr>\n")
+		for _, p := range props {
+			fmt.Fprintf(&buf, "<tr><td>%s</td><td>%s</td></tr>\n", p.label, esc(p.value))
+		}
+		buf.WriteString("</table>\n")
+	}
+
+	buf.WriteString("<h2>Sheets</h2>\n<table>\n<tr><th>#</th><th>Name</th><th>Visible</th><th>Used range</th><th>Rows</th><th>Columns</th><th>Cells</th><th>Merged</th><th>Validations</th><th>Images</th></tr>\n")
+	for _, sheet := range metadata.Sheets {
+		fmt.Fprintf(&buf, "<tr><td class=\"num\">%d</td><td>%s</td><td>%s</td><td>%s</td><td class=\"num\">%d</td><td class=\"num\">%d</td><td class=\"num\">%d</td><td class=\"num\">%d</td><td class=\"num\">%d</td><td class=\"num\">%d</td></tr>\n",
+			sheet.Index, esc(sheet.Name), yesNo(sheet.Visible), esc(usedRange(sheet.Dimensions)),
+			sheet.Dimensions.RowCount, sheet.Dimensions.ColCount, len(sheet.Cells),
+			len(sheet.MergedCells), len(sheet.DataValidations), len(sheet.Images))
+	}
+	buf.WriteString("</table>\n")
+
+	if len(metadata.DefinedNames) > 0 {
+		buf.WriteString("<h2>Defined Names</h2>\n<table>\n<tr><th>Name</th><th>Scope</th><th>Refers to</th></tr>\n")
+		for _, dn := range metadata.DefinedNames {
+			fmt.Fprintf(&buf, "<tr><td>%s</td><td>%s</td><td><code>%s</code></td></tr>\n", esc(dn.Name), esc(definedNameScope(dn)), esc(dn.RefersTo))
+		}
+		buf.WriteString("</table>\n")
+	}
+
+	if hasValidations(metadata) {
+		buf.WriteString("<h2>Data Validations</h2>\n<table>\n<tr><th>Sheet</th><th>Range</th><th>Type</th><th>Operator</th><th>Formula 1</th><th>Formula 2</th><th>Error message</th></tr>\n")
+		for _, sheet := range metadata.Sheets {
+			for _, dv := range sheet.DataValidations {
+				fmt.Fprintf(&buf, "<tr><td>%s</td><td>%s</td><td>%s</td><td>%s</td><td>%s</td><td>%s</td><td>%s</td></tr>\n",
+					esc(sheet.Name), esc(dv.Range), esc(dv.Type), esc(dv.Operator),
+					esc(dv.Formula1), esc(dv.Formula2), esc(stringValue(dv.ErrorMessage)))
+			}
+		}
+		buf.WriteString("</table>\n")
+	}
+
+	if hasMergedCells(metadata) {
+		buf.WriteString("<h2>Merged Ranges</h2>\n<table>\n<tr><th>Sheet</th><th>Range</th><th>Value</th></tr>\n")
+		for _, sheet := range metadata.Sheets {
+			for _, mc := range sheet.MergedCells {
+				fmt.Fprintf(&buf, "<tr><td>%s</td><td>%s:%s</td><td>%s</td></tr>\n", esc(sheet.Name), esc(mc.StartCell), esc(mc.EndCell), esc(mc.Value))
+			}
+		}
+		buf.WriteString("</table>\n")
+	}
+
+	for _, sheet := range metadata.Sheets {
+		grid := newPreviewGrid(sheet, rows, previewCols(options))
+		if len(grid.rows) == 0 && len(sheet.Images) == 0 {
+			continue
+		}
+		fmt.Fprintf(&buf, "<h2>Preview: %s</h2>\n", esc(sheet.Name))
+
+		if len(grid.rows) > 0 {
+			writeHTMLGrid(&buf, sheet, grid, metadata.Styles)
+			if grid.truncated {
+				fmt.Fprintf(&buf, "<p class=\"note\">Showing %d of %d rows.</p>\n", len(grid.rows), sheet.Dimensions.RowCount)
+			}
+		}
+
+		if len(sheet.Images) > 0 {
+			buf.WriteString("<div class=\"images\">\n")
+			for _, img := range sheet.Images {
+				caption := esc(fmt.Sprintf("%s!%s", sheet.Name, img.Cell))
+				if mime := imageMIMEType(img.Extension); mime != "" {
+					fmt.Fprintf(&buf, "<figure><img src=\"data:%s;base64,%s\" alt=\"%s\"><figcaption>%s</figcaption></figure>\n",
+						mime, base64.StdEncoding.EncodeToString(img.File), caption, caption)
+				} else {
+					fmt.Fprintf(&buf, "<figure><figcaption>%s (%s, %d bytes)</figcaption></figure>\n", caption, esc(img.Extension), len(img.File))
+				}
+			}
+			buf.WriteString("</div>\n")
+		}
+	}
+
+	buf.WriteString("</body>\n</html>\n")
+	return buf.Bytes(), nil
+}
+
+const reportCSS = `body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2em; color: #222; }
+table { border-collapse: collapse; margin-bottom: 1.5em; }
+th, td { border: 1px solid #ccc; padding: 2px 6px; vertical-align: top; }
+th { background: #f3f3f3; }
+td.num { text-align: right; }
+table.grid td { white-space: pre-wrap; }
+table.grid th { font-weight: normal; color: #666; }
+.note { color: #666; font-style: italic; }
+.images figure { display: inline-block; margin: 0 1em 1em 0; }
+.images img { max-width: 160px; max-height: 120px; border: 1px solid #ccc; }
+`
+
+// previewGrid is the block of cells shown for a sheet
+type previewGrid struct {
+	cols      []string
+	rows      []int
+	cells     map[string]CellMetadata
+	truncated bool
+}
+
+func (g previewGrid) cell(col string, row int) (CellMetadata, bool) {
+	c, ok := g.cells[fmt.Sprintf("%s%d", col, row)]
+	return c, ok
+}
+
+// newPreviewGrid covers the first maxRows rows of the sheet's used range,
+// trimmed to the rows and columns that hold cells
+func newPreviewGrid(sheet SheetMetadata, maxRows, maxCols int) previewGrid {
+	grid := previewGrid{cells: map[string]CellMetadata{}}
+	if maxRows <= 0 || len(sheet.Cells) == 0 {
+		return grid
+	}
+
+	firstRow, firstCol := 0, 0
+	lastRow, lastCol := 0, 0
+	for _, cell := range sheet.Cells {
+		col, row, err := excelize.CellNameToCoordinates(cell.Address)
+		if err != nil {
+			continue
+		}
+		if firstRow == 0 || row < firstRow {
+			firstRow = row
+		}
+		if firstCol == 0 || col < firstCol {
+			firstCol = col
+		}
+		if row > lastRow {
+			lastRow = row
+		}
+		if col > lastCol {
+			lastCol = col
+		}
+		grid.cells[cell.Address] = cell
+	}
+	if lastRow == 0 {
+		return grid
+	}
+
+	// Merged ranges can reach beyond the last populated column
+	for _, mc := range sheet.MergedCells {
+		if col, _, err := excelize.CellNameToCoordinates(mc.EndCell); err == nil && col > lastCol {
+			lastCol = col
+		}
+	}
+
+	if lastRow-firstRow+1 > maxRows {
+		lastRow = firstRow + maxRows - 1
+		grid.truncated = true
+	}
+	if maxCols > 0 && lastCol-firstCol+1 > maxCols {
+		lastCol = firstCol + maxCols - 1
+	}
+	for row := firstRow; row <= lastRow; row++ {
+		grid.rows = append(grid.rows, row)
+	}
+	for col := firstCol; col <= lastCol; col++ {
+		name, _ := excelize.ColumnNumberToName(col)
+		grid.cols = append(grid.cols, name)
+	}
+	return grid
+}
+
+func writeHTMLGrid(buf *bytes.Buffer, sheet SheetMetadata, grid previewGrid, styles map[int]StyleDetails) {
+	firstRow, lastRow := grid.rows[0], grid.rows[len(grid.rows)-1]
+	firstCol, _ := excelize.ColumnNameToNumber(grid.cols[0])
+	lastCol := firstCol + len(grid.cols) - 1
+
+	// Merged ranges become a spanning top-left cell, the rest is skipped
+	spans := map[string][2]int{}
+	covered := map[string]bool{}
+	for _, mc := range sheet.MergedCells {
+		c1, r1, err1 := excelize.CellNameToCoordinates(mc.StartCell)
+		c2, r2, err2 := excelize.CellNameToCoordinates(mc.EndCell)
+		if err1 != nil || err2 != nil || r1 > lastRow || c1 > lastCol || r2 < firstRow || c2 < firstCol {
+			continue
+		}
+		r1, c1 = max(r1, firstRow), max(c1, firstCol)
+		r2, c2 = min(r2, lastRow), min(c2, lastCol)
+		start, _ := excelize.CoordinatesToCellName(c1, r1)
+		spans[start] = [2]int{r2 - r1 + 1, c2 - c1 + 1}
+		for r := r1; r <= r2; r++ {
+			for c := c1; c <= c2; c++ {
+				if addr, _ := excelize.CoordinatesToCellName(c, r); addr != start {
+					covered[addr] = true
+				}
+			}
+		}
+	}
+
+	buf.WriteString("<table class=\"grid\">\n<tr><th></th>")
+	for _, col := range grid.cols {
+		fmt.Fprintf(buf, "<th>%s</th>", col)
+	}
+	buf.WriteString("</tr>\n")
+
+	for _, row := range grid.rows {
+		fmt.Fprintf(buf, "<tr><th>%d</th>", row)
+		for _, col := range grid.cols {
+			addr := fmt.Sprintf("%s%d", col, row)
+			if covered[addr] {
+				continue
+			}
+			buf.WriteString("<td")
+			if span, ok := spans[addr]; ok {
+				if span[0] > 1 {
+					fmt.Fprintf(buf, " rowspan=\"%d\"", span[0])
+				}
+				if span[1] > 1 {
+					fmt.Fprintf(buf, " colspan=\"%d\"", span[1])
+				}
+			}
+			cell, ok := grid.cells[addr]
+			if ok && cell.StyleID != 0 && styleCSS(styles[cell.StyleID]) != "" {
+				fmt.Fprintf(buf, " class=\"s%d\"", cell.StyleID)
+			}
+			if ok && cell.Formula != "" {
+				fmt.Fprintf(buf, " title=\"=%s\"", html.EscapeString(cell.Formula))
+			}
+			buf.WriteString(">")
+			if ok {
+				text := html.EscapeString(reportValue(cell.Value))
+				if cell.Hyperlink != nil && linkable(cell.Hyperlink.Link) {
+					text = fmt.Sprintf("<a href=\"%s\">%s</a>", html.EscapeString(cell.Hyperlink.Link), text)
+				}
+				buf.WriteString(text)
+			}
+			buf.WriteString("</td>")
+		}
+		buf.WriteString("</tr>\n")
+	}
+	buf.WriteString("</table>\n")
+}
+
+// styleClasses renders one CSS class per workbook style, named s<ID>
+func styleClasses(styles map[int]StyleDetails) string {
+	ids := make([]int, 0, len(styles))
+	for id := range styles {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	var sb strings.Builder
+	for _, id := range ids {
+		if css := styleCSS(styles[id]); css != "" {
+			fmt.Fprintf(&sb, "table.grid td.s%d { %s; }\n", id, css)
+		}
+	}
+	return sb.String()
+}
+
+// styleCSS translates a workbook style into inline CSS declarations
+func styleCSS(style StyleDetails) string {
+	var decls []string
+	if f := style.Font; f != nil {
+		if f.Bold {
+			decls = append(decls, "font-weight: bold")
+		}
+		if f.Italic {
+			decls = append(decls, "font-style: italic")
+		}
+		var lines []string
+		if f.Underline != "" && f.Underline != "none" {
+			lines = append(lines, "underline")
+		}
+		if f.Strike {
+			lines = append(lines, "line-through")
+		}
+		if len(lines) > 0 {
+			decls = append(decls, "text-decoration: "+strings.Join(lines, " "))
+		}
+		if f.Family != "" {
+			decls = append(decls, fmt.Sprintf("font-family: '%s'", strings.NewReplacer("'", "", "\\", "", "<", "", ">", "", ";", "").Replace(f.Family)))
+		}
+		if f.Size > 0 {
+			decls = append(decls, fmt.Sprintf("font-size: %gpt", f.Size))
+		}
+		if color := cssColor(f.Color); color != "" {
+			decls = append(decls, "color: "+color)
+		}
+	}
+	if fill := style.Fill; fill != nil && len(fill.Color) > 0 && (fill.Type != "pattern" || fill.Pattern != 0) {
+		if color := cssColor(fill.Color[0]); color != "" {
+			decls = append(decls, "background-color: "+color)
+		}
+	}
+	for _, b := range style.Border {
+		side := b.Type
+		if side != "left" && side != "right" && side != "top" && side != "bottom" {
+			continue
+		}
+		color := cssColor(b.Color)
+		if color == "" {
+			color = "#000"
+		}
+		decls = append(decls, fmt.Sprintf("border-%s: %s %s", side, cssBorderStyle(b.Style), color))
+	}
+	if a := style.Alignment; a != nil {
+		switch a.Horizontal {
+		case "left", "center", "right", "justify":
+			decls = append(decls, "text-align: "+a.Horizontal)
+		case "centerContinuous":
+			decls = append(decls, "text-align: center")
+		}
+		switch a.Vertical {
+		case "top", "bottom":
+			decls = append(decls, "vertical-align: "+a.Vertical)
+		case "center":
+			decls = append(decls, "vertical-align: middle")
+		}
+		if a.Indent > 0 {
+			decls = append(decls, fmt.Sprintf("padding-left: %dem", a.Indent))
+		}
+	}
+	return strings.Join(decls, "; ")
+}
+
+// cssColor converts excelize RGB and ARGB hex colors to CSS
+func cssColor(color string) string {
+	color = strings.TrimPrefix(color, "#")
+	if len(color) == 8 {
+		color = color[2:]
+	}
+	if len(color) != 6 && len(color) != 3 {
+		return ""
+	}
+	for _, r := range color {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", r) {
+			return ""
+		}
+	}
+	return "#" + strings.ToUpper(color)
+}
+
+// cssBorderStyle maps excelize border style indexes to CSS borders
+func cssBorderStyle(style int) string {
+	switch style {
+	case 2:
+		return "2px solid"
+	case 3, 9, 11:
+		return "1px dashed"
+	case 4, 7:
+		return "1px dotted"
+	case 5:
+		return "3px solid"
+	case 6:
+		return "3px double"
+	case 8, 10, 12, 13:
+		return "2px dashed"
+	default:
+		return "1px solid"
+	}
+}
+
+// linkable reports whether a hyperlink of an untrusted workbook may be
+// rendered as a live link. Other schemes, such as javascript: and file:,
+// and internal locations are rendered as text.
+func linkable(target string) bool {
+	u, err := url.Parse(target)
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https", "mailto":
+		return true
+	}
+	return false
+}
+
+// mdLinkTarget percent-encodes the characters that would end a Markdown link
+// target or a table cell, or open an HTML tag
+var mdLinkTarget = strings.NewReplacer(
+	"(", "%28",
+	")", "%29",
+	" ", "%20",
+	"|", "%7C",
+	"<", "%3C",
+	">", "%3E",
+	"\r", "%0D",
+	"\n", "%0A",
+)
+
+func mdCell(cell CellMetadata, ok bool, styles map[int]StyleDetails) string {
+	if !ok {
+		return ""
+	}
+	text := mdEscape(reportValue(cell.Value))
+	if text == "" {
+		return ""
+	}
+	if cell.Hyperlink != nil && linkable(cell.Hyperlink.Link) {
+		text = fmt.Sprintf("[%s](%s)", text, mdLinkTarget.Replace(cell.Hyperlink.Link))
+	}
+	if f := styles[cell.StyleID].Font; cell.StyleID != 0 && f != nil {
+		if f.Strike {
+			text = "~~" + text + "~~"
+		}
+		if f.Italic {
+			text = "_" + text + "_"
+		}
+		if f.Bold {
+			text = "**" + text + "**"
+		}
+	}
+	return text
+}
+
+// mdEscape makes s safe to place inside a Markdown table cell
+func mdEscape(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		"|", `\|`,
+		"*", `\*`,
+		"_", `\_`,
+		"`", "\\`",
+		"[", `\[`,
+		"]", `\]`,
+		"<", "&lt;",
+		">", "&gt;",
+		"\r\n", "<br>",
+		"\n", "<br>",
+	).Replace(s)
+}
+
+// mdCode makes s safe to place inside a code span in a table cell
+func mdCode(s string) string {
+	return strings.NewReplacer("|", `\|`, "`", "'", "\n", " ").Replace(s)
+}
+
+func reportTitle(metadata *Metadata) string {
+	if metadata.Properties.Title != "" {
+		return metadata.Properties.Title
+	}
+	if metadata.Filename != "" {
+		return metadata.Filename
+	}
+	return "Workbook"
+}
+
+func reportValue(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+func previewRows(options *ReportOptions) int {
+	if options == nil || options.PreviewRows == 0 {
+		return DefaultPreviewRows
+	}
+	return options.PreviewRows
+}
+
+func previewCols(options *ReportOptions) int {
+	if options == nil {
+		return 0
+	}
+	return options.PreviewCols
+}
+
+func usedRange(dim SheetDimensions) string {
+	if dim.StartCell == "" || dim.EndCell == "" {
+		return ""
+	}
+	return dim.StartCell + ":" + dim.EndCell
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+func definedNameScope(dn DefinedName) string {
+	if dn.Scope == "" {
+		return "Workbook"
+	}
+	return dn.Scope
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func hasValidations(metadata *Metadata) bool {
+	for _, sheet := range metadata.Sheets {
+		if len(sheet.DataValidations) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func hasMergedCells(metadata *Metadata) bool {
+	for _, sheet := range metadata.Sheets {
+		if len(sheet.MergedCells) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// imageMIMEType returns the MIME type of images browsers can display
+func imageMIMEType(extension string) string {
+	switch strings.ToLower(strings.TrimPrefix(extension, ".")) {
+	case "png":
+		return "image/png"
+	case "jpg", "jpeg":
+		return "image/jpeg"
+	case "gif":
+		return "image/gif"
+	case "bmp":
+		return "image/bmp"
+	case "svg":
+		return "image/svg+xml"
+	case "webp":
+		return "image/webp"
+	case "tif", "tiff":
+		return "image/tiff"
+	}
+	return ""
+}
+
+// propertyField is a non-empty document property
+type propertyField struct {
+	name, label, value string
+}
+
+// propertyFields lists the non-empty document properties in a fixed order
+func propertyFields(props DocumentProperties) []propertyField {
+	var fields []propertyField
+	for _, p := range []propertyField{
+		{"title", "Title", props.Title},
+		{"subject", "Subject", props.Subject},
+		{"creator", "Creator", props.Creator},
+		{"keywords", "Keywords", props.Keywords},
+		{"description", "Description", props.Description},
+		{"lastModifiedBy", "Last modified by", props.LastModifiedBy},
+		{"category", "Category", props.Category},
+		{"version", "Version", props.Version},
+		{"created", "Created", props.Created},
+		{"modified", "Modified", props.Modified},
+	} {
+		if p.value != "" {
+			fields = append(fields, p)
+		}
+	}
+	return fields
+}
@@ -0,0 +1,195 @@
+package excelmetadata_test
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/prongbang/excelmetadata"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestMarshalReports(t *testing.T) {
+	metadata := extract(t, newWorkbook(t, func(f *excelize.File) {
+		_ = f.SetDocProps(&excelize.DocProperties{Title: "Quarterly | Report", Creator: "Finance"})
+		bold, _ := f.NewStyle(&excelize.Style{
+			Font: &excelize.Font{Bold: true, Color: "FF0000"},
+			Fill: excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{"FFFF00"}},
+		})
+		_ = f.SetCellValue("Sheet1", "A1", "Region")
+		_ = f.SetCellStyle("Sheet1", "A1", "A1", bold)
+		_ = f.SetCellValue("Sheet1", "B1", "<Total>")
+		_ = f.SetCellValue("Sheet1", "A2", "North")
+		_ = f.SetCellValue("Sheet1", "B2", 120)
+		_ = f.SetCellValue("Sheet1", "A3", "South")
+		_ = f.SetCellValue("Sheet1", "C1", "Merged")
+		_ = f.MergeCell("Sheet1", "C1", "D2")
+		_ = f.SetDefinedName(&excelize.DefinedName{Name: "Regions", RefersTo: "Sheet1!$A$2:$A$3"})
+
+		dv := excelize.NewDataValidation(true)
+		dv.Sqref = "B2:B3"
+		_ = dv.SetRange(0, 1000, excelize.DataValidationTypeWhole, excelize.DataValidationOperatorBetween)
+		dv.SetError(excelize.DataValidationErrorStyleStop, "Invalid", "Enter 0 to 1000")
+		_ = f.AddDataValidation("Sheet1", dv)
+
+		_, _ = f.NewSheet("Hidden")
+		_ = f.SetCellValue("Hidden", "A1", "secret")
+		_ = f.SetSheetVisible("Hidden", false)
+
+		var buf bytes.Buffer
+		_ = png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 1, 1)))
+		if err := f.AddPictureFromBytes("Sheet1", "F2", &excelize.Picture{Extension: ".png", File: buf.Bytes()}); err != nil {
+			t.Fatal(err)
+		}
+	}))
+
+	tests := []struct {
+		name    string
+		marshal func() ([]byte, error)
+		want    []string
+		notWant []string
+	}{
+		{
+			name: "markdown",
+			marshal: func() ([]byte, error) {
+				return excelmetadata.MarshalMarkdown(metadata, nil)
+			},
+			want: []string{
+				"# Quarterly \\| Report\n",
+				"| Creator | Finance |",
+				"| Hidden | no |",
+				"| Regions | Workbook | `Sheet1!$A$2:$A$3` |",
+				"| Sheet1 | B2:B3 | whole | between | 0 | 1000 | Enter 0 to 1000 |",
+				"| Sheet1 | C1:D2 | Merged |",
+				"|   | A | B | C | D |",
+				"| 1 | **Region** | &lt;Total&gt; | Merged |  |",
+				"| 3 | South |  |  |  |",
+				"![Sheet1!F2](data:image/png;base64,",
+			},
+		},
+		{
+			name: "html",
+			marshal: func() ([]byte, error) {
+				return excelmetadata.MarshalHTML(metadata, &excelmetadata.ReportOptions{PreviewRows: 2})
+			},
+			want: []string{
+				"<!DOCTYPE html>",
+				"<title>Quarterly | Report</title>",
+				"font-weight: bold",
+				"color: #FF0000",
+				"background-color: #FFFF00",
+				"<td>&lt;Total&gt;</td>",
+				`<td rowspan="2" colspan="2">Merged</td>`,
+				"<td>Hidden</td><td>no</td>",
+				"Showing 2 of 3 rows.",
+				`<img src="data:image/png;base64,`,
+			},
+			// The preview stops after PreviewRows rows
+			notWant: []string{"<th>3</th>"},
+		},
+		{
+			name: "html encoder",
+			marshal: func() ([]byte, error) {
+				var buf bytes.Buffer
+				err := excelmetadata.Encode(&buf, "html", metadata, nil)
+				return buf.Bytes(), err
+			},
+			// The encoder uses the default preview rows
+			want: []string{"<th>3</th>"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := tt.marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(string(report), want) {
+					t.Errorf("report does not contain %q:\n%s", want, report)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(string(report), notWant) {
+					t.Errorf("report contains %q:\n%s", notWant, report)
+				}
+			}
+		})
+	}
+}
+
+func TestReportHyperlinks(t *testing.T) {
+	metadata := extract(t, newWorkbook(t, func(f *excelize.File) {
+		for cell, link := range map[string]string{
+			"A1": "https://example.com/a",
+			"A2": "mailto:ops@example.com",
+			"A3": "javascript:alert(1)",
+			"A4": " JavaScript:alert(2)",
+			"A5": "file:///C:/Temp/run.exe",
+			"A6": "https://example.com/a b|c<d>",
+		} {
+			_ = f.SetCellValue("Sheet1", cell, "link "+cell)
+			_ = f.SetCellHyperLink("Sheet1", cell, link, "External")
+		}
+	}))
+
+	md, err := excelmetadata.MarshalMarkdown(metadata, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	page, err := excelmetadata.MarshalHTML(metadata, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"[link A1](https://example.com/a)", "[link A2](mailto:ops@example.com)", "| link A3 |", "| link A5 |",
+		"[link A6](https://example.com/a%20b%7Cc%3Cd%3E)",
+	} {
+		if !strings.Contains(string(md), want) {
+			t.Errorf("Markdown report does not contain %q:\n%s", want, md)
+		}
+	}
+	for _, want := range []string{`<a href="https://example.com/a">link A1</a>`, "<td>link A3</td>", "<td>link A4</td>"} {
+		if !strings.Contains(string(page), want) {
+			t.Errorf("HTML report does not contain %q:\n%s", want, page)
+		}
+	}
+	for _, report := range [][]byte{md, page} {
+		if s := strings.ToLower(string(report)); strings.Contains(s, "javascript:") || strings.Contains(s, "file:") {
+			t.Errorf("report links an unsafe target:\n%s", report)
+		}
+	}
+}
+
+func TestReportEscapesRanges(t *testing.T) {
+	// Metadata JSON given to the report command is not trusted
+	metadata := &excelmetadata.Metadata{
+		Sheets: []excelmetadata.SheetMetadata{{
+			Name:        "Sheet1",
+			Visible:     true,
+			Dimensions:  excelmetadata.SheetDimensions{StartCell: "<img src=x onerror=alert(1)>", EndCell: "B2|x"},
+			MergedCells: []excelmetadata.MergedCell{{StartCell: "<script>alert(2)</script>", EndCell: "C3|y", Value: "v"}},
+		}},
+	}
+
+	md, err := excelmetadata.MarshalMarkdown(metadata, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	page, err := excelmetadata.MarshalHTML(metadata, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, report := range [][]byte{md, page} {
+		if strings.Contains(string(report), "<img") || strings.Contains(string(report), "<script>") {
+			t.Errorf("report contains unescaped markup:\n%s", report)
+		}
+	}
+	for _, want := range []string{`B2\|x`, `C3\|y`} {
+		if !strings.Contains(string(md), want) {
+			t.Errorf("Markdown report does not contain %q:\n%s", want, md)
+		}
+	}
+}
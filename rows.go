@@ -0,0 +1,245 @@
+package excelmetadata
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// CellError describes a cell that could not be mapped into a struct field
+type CellError struct {
+	Sheet  string `json:"sheet"`
+	Cell   string `json:"cell"`
+	Column string `json:"column"`
+	Value  string `json:"value"`
+	Err    error  `json:"-"`
+}
+
+func (e CellError) Error() string {
+	return fmt.Sprintf("%s!%s (%s): %q: %v", e.Sheet, e.Cell, e.Column, e.Value, e.Err)
+}
+
+func (e CellError) Unwrap() error {
+	return e.Err
+}
+
+// CellErrors collects every cell that failed validation while reading rows
+type CellErrors []CellError
+
+func (e CellErrors) Error() string {
+	if len(e) == 1 {
+		return e[0].Error()
+	}
+	msgs := make([]string, 0, len(e))
+	for _, ce := range e {
+		msgs = append(msgs, ce.Error())
+	}
+	return fmt.Sprintf("%d invalid cells: %s", len(e), strings.Join(msgs, "; "))
+}
+
+// ReadRows maps the rows of a sheet into []T using `xlsx:"Column Name"` tags.
+//
+// ref locates the data: a single cell ("A1") is the first header cell and
+// data extends right and down to the end of the sheet, while a range
+// ("A1:F20", e.g. a table) bounds header and data. Empty rows are skipped.
+// Rows are returned even when some cells fail to parse; those cells are
+// reported together as CellErrors.
+func ReadRows[T any](f *excelize.File, sheet, ref string) ([]T, error) {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	if typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("ReadRows requires a struct type, got %s", typ)
+	}
+
+	startCol, startRow, endCol, endRow, err := parseRowsRef(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := f.GetRows(sheet, excelize.Options{RawCellValue: true})
+	if err != nil {
+		return nil, err
+	}
+	if startRow > len(rows) {
+		return nil, fmt.Errorf("header row %d is beyond the last row of sheet %q", startRow, sheet)
+	}
+
+	// Map header names to column indexes
+	header := rows[startRow-1]
+	columns := make(map[string]int)
+	for col := startCol; col <= len(header) && (endCol == 0 || col <= endCol); col++ {
+		if name := strings.TrimSpace(header[col-1]); name != "" {
+			if _, exists := columns[name]; !exists {
+				columns[name] = col
+			}
+		}
+	}
+
+	type fieldColumn struct {
+		index int
+		name  string
+		col   int
+	}
+	var fields []fieldColumn
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		tag := field.Tag.Get("xlsx")
+		if tag == "" || tag == "-" || !field.IsExported() {
+			continue
+		}
+		col, ok := columns[tag]
+		if !ok {
+			return nil, fmt.Errorf("column %q not found in header row %d of sheet %q", tag, startRow, sheet)
+		}
+		fields = append(fields, fieldColumn{index: i, name: tag, col: col})
+	}
+
+	last := len(rows)
+	if endRow > 0 && endRow < last {
+		last = endRow
+	}
+
+	var result []T
+	var cellErrs CellErrors
+	for rowIdx := startRow + 1; rowIdx <= last; rowIdx++ {
+		row := rows[rowIdx-1]
+
+		empty := true
+		for _, fc := range fields {
+			if fc.col <= len(row) && strings.TrimSpace(row[fc.col-1]) != "" {
+				empty = false
+				break
+			}
+		}
+		if empty {
+			continue
+		}
+
+		var item T
+		v := reflect.ValueOf(&item).Elem()
+		for _, fc := range fields {
+			raw := ""
+			if fc.col <= len(row) {
+				raw = row[fc.col-1]
+			}
+			if err := setField(v.Field(fc.index), raw); err != nil {
+				cell, _ := excelize.CoordinatesToCellName(fc.col, rowIdx)
+				cellErrs = append(cellErrs, CellError{
+					Sheet:  sheet,
+					Cell:   cell,
+					Column: fc.name,
+					Value:  raw,
+					Err:    err,
+				})
+			}
+		}
+		result = append(result, item)
+	}
+
+	if len(cellErrs) > 0 {
+		return result, cellErrs
+	}
+	return result, nil
+}
+
+func parseRowsRef(ref string) (startCol, startRow, endCol, endRow int, err error) {
+	if ref == "" {
+		ref = "A1"
+	}
+	parts := strings.SplitN(ref, ":", 2)
+	startCol, startRow, err = excelize.CellNameToCoordinates(parts[0])
+	if err != nil {
+		return 0, 0, 0, 0, fmt.Errorf("invalid reference %q: %w", ref, err)
+	}
+	if len(parts) == 2 {
+		endCol, endRow, err = excelize.CellNameToCoordinates(parts[1])
+		if err != nil {
+			return 0, 0, 0, 0, fmt.Errorf("invalid reference %q: %w", ref, err)
+		}
+	}
+	return startCol, startRow, endCol, endRow, nil
+}
+
+// setField parses a raw cell value into a struct field
+func setField(field reflect.Value, raw string) error {
+	raw = strings.TrimSpace(raw)
+
+	if field.Kind() == reflect.Ptr {
+		if raw == "" {
+			return nil
+		}
+		ptr := reflect.New(field.Type().Elem())
+		if err := setField(ptr.Elem(), raw); err != nil {
+			return err
+		}
+		field.Set(ptr)
+		return nil
+	}
+	if raw == "" {
+		return nil
+	}
+
+	if field.Type() == timeType {
+		if serial, err := strconv.ParseFloat(raw, 64); err == nil {
+			t, err := excelize.ExcelDateToTime(serial, false)
+			if err != nil {
+				return err
+			}
+			field.Set(reflect.ValueOf(t))
+			return nil
+		}
+		for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"} {
+			if t, err := time.Parse(layout, raw); err == nil {
+				field.Set(reflect.ValueOf(t))
+				return nil
+			}
+		}
+		return fmt.Errorf("not a date")
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(raw)
+	case reflect.Bool:
+		switch strings.ToUpper(raw) {
+		case "1", "TRUE", "YES", "Y":
+			field.SetBool(true)
+		case "0", "FALSE", "NO", "N":
+			field.SetBool(false)
+		default:
+			return fmt.Errorf("not a boolean")
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, field.Type().Bits())
+		if err != nil {
+			// Numbers are stored as floats; accept whole floats such as "3.0"
+			f, ferr := strconv.ParseFloat(raw, 64)
+			if ferr != nil || f != float64(int64(f)) {
+				return fmt.Errorf("not an integer")
+			}
+			n = int64(f)
+			if field.OverflowInt(n) {
+				return fmt.Errorf("integer out of range")
+			}
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("not an unsigned integer")
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(raw, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("not a number")
+		}
+		field.SetFloat(n)
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+	return nil
+}
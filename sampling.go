@@ -0,0 +1,60 @@
+package excelmetadata
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+)
+
+// Sampling strategies choosing which rows and cells are kept when MaxRows or
+// MaxCellsPerSheet is exceeded
+const (
+	// SampleHead keeps the first rows and cells
+	SampleHead = "head"
+	// SampleTail keeps the last rows and cells
+	SampleTail = "tail"
+	// SampleStride keeps evenly spaced rows and cells
+	SampleStride = "stride"
+	// SampleRandom keeps a random selection seeded by Options.SampleSeed
+	SampleRandom = "random"
+)
+
+// samplingStrategy returns the normalised strategy of the options
+func samplingStrategy(options *Options) (string, error) {
+	switch strategy := strings.ToLower(options.Sampling); strategy {
+	case "":
+		return SampleHead, nil
+	case SampleHead, SampleTail, SampleStride, SampleRandom:
+		return strategy, nil
+	default:
+		return "", fmt.Errorf("unknown sampling strategy %q", options.Sampling)
+	}
+}
+
+// sampleIndexes picks n of total indexes with the given strategy and returns
+// them in ascending order. All indexes are returned when n <= 0 or n >= total.
+func sampleIndexes(total, n int, strategy string, seed int64) []int {
+	if n <= 0 || n >= total {
+		n = total
+	}
+	indexes := make([]int, n)
+	switch {
+	case n == total || strategy == SampleHead:
+		for i := range indexes {
+			indexes[i] = i
+		}
+	case strategy == SampleTail:
+		for i := range indexes {
+			indexes[i] = total - n + i
+		}
+	case strategy == SampleStride:
+		for i := range indexes {
+			indexes[i] = i * total / n
+		}
+	case strategy == SampleRandom:
+		copy(indexes, rand.New(rand.NewSource(seed)).Perm(total)[:n])
+		sort.Ints(indexes)
+	}
+	return indexes
+}
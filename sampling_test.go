@@ -0,0 +1,152 @@
+package excelmetadata_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/prongbang/excelmetadata"
+	"github.com/xuri/excelize/v2"
+)
+
+func cellAddresses(sheet excelmetadata.SheetMetadata) []string {
+	var addresses []string
+	for _, cell := range sheet.Cells {
+		addresses = append(addresses, cell.Address)
+	}
+	return addresses
+}
+
+func TestSampling(t *testing.T) {
+	// 10 rows of 4 columns, with an empty row 5
+	filename := newWorkbook(t, func(f *excelize.File) {
+		for row := 1; row <= 11; row++ {
+			if row == 5 {
+				continue
+			}
+			for col := 1; col <= 4; col++ {
+				cell, _ := excelize.CoordinatesToCellName(col, row)
+				_ = f.SetCellValue("Sheet1", cell, cell)
+			}
+		}
+	})
+
+	tests := []struct {
+		name      string
+		configure func(*excelmetadata.Options)
+		want      []string
+		sampling  string
+		total     int
+		wantErr   bool
+	}{
+		{
+			name:      "no limits",
+			configure: func(o *excelmetadata.Options) { o.Ranges = []string{"Sheet1!A1:A11"} },
+			want:      []string{"A1", "A2", "A3", "A4", "A6", "A7", "A8", "A9", "A10", "A11"},
+			total:     10,
+		},
+		{
+			name: "head rows and columns",
+			configure: func(o *excelmetadata.Options) {
+				o.MaxRows = 2
+				o.MaxCols = 2
+			},
+			want:     []string{"A1", "B1", "A2", "B2"},
+			sampling: excelmetadata.SampleHead,
+			total:    40,
+		},
+		{
+			name: "tail rows",
+			configure: func(o *excelmetadata.Options) {
+				o.MaxRows = 2
+				o.MaxCols = 1
+				o.Sampling = excelmetadata.SampleTail
+			},
+			want:     []string{"A10", "A11"},
+			sampling: excelmetadata.SampleTail,
+			total:    40,
+		},
+		{
+			name: "stride rows skip empty rows",
+			configure: func(o *excelmetadata.Options) {
+				o.MaxRows = 5
+				o.MaxCols = 1
+				o.Sampling = excelmetadata.SampleStride
+			},
+			want:     []string{"A1", "A3", "A6", "A8", "A10"},
+			sampling: excelmetadata.SampleStride,
+			total:    40,
+		},
+		{
+			name: "tail cells",
+			configure: func(o *excelmetadata.Options) {
+				o.MaxCellsPerSheet = 3
+				o.Sampling = "TAIL"
+			},
+			want:     []string{"B11", "C11", "D11"},
+			sampling: excelmetadata.SampleTail,
+			total:    40,
+		},
+		{
+			name:      "head cells by default",
+			configure: func(o *excelmetadata.Options) { o.MaxCellsPerSheet = 3 },
+			want:      []string{"A1", "B1", "C1"},
+			sampling:  excelmetadata.SampleHead,
+			total:     40,
+		},
+		{
+			name:      "unknown strategy",
+			configure: func(o *excelmetadata.Options) { o.Sampling = "middle" },
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := excelmetadata.DefaultOptions()
+			tt.configure(options)
+			extractor, err := excelmetadata.New(filename, options)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			defer extractor.Close()
+
+			metadata, err := extractor.Extract()
+			if err != nil {
+				t.Fatal(err)
+			}
+			sheet := metadata.Sheets[0]
+			if got := cellAddresses(sheet); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("cells = %v, want %v", got, tt.want)
+			}
+			if sheet.Truncated != (tt.sampling != "") || sheet.Sampling != tt.sampling {
+				t.Errorf("truncated = %v with %q, want %q", sheet.Truncated, sheet.Sampling, tt.sampling)
+			}
+			if sheet.TotalNonEmptyCells != tt.total {
+				t.Errorf("total = %d, want %d", sheet.TotalNonEmptyCells, tt.total)
+			}
+		})
+	}
+
+	t.Run("random sampling is seeded", func(t *testing.T) {
+		sample := func(seed int64) []string {
+			return cellAddresses(extractWithOptions(t, filename, func(o *excelmetadata.Options) {
+				o.MaxCellsPerSheet = 8
+				o.Sampling = excelmetadata.SampleRandom
+				o.SampleSeed = seed
+			}).Sheets[0])
+		}
+
+		first := sample(42)
+		if len(first) != 8 {
+			t.Fatalf("got %d cells, want 8", len(first))
+		}
+		if again := sample(42); !reflect.DeepEqual(again, first) {
+			t.Errorf("same seed gave %v and %v", first, again)
+		}
+		if other := sample(7); reflect.DeepEqual(other, first) {
+			t.Errorf("different seeds gave the same sample %v", first)
+		}
+	})
+}
@@ -0,0 +1,118 @@
+package excelmetadata
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+const sarifSchema = "https://json.schemastore.org/sarif-2.1.0.json"
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string       `json:"id"`
+	ShortDescription     sarifMessage `json:"shortDescription"`
+	DefaultConfiguration struct {
+		Level string `json:"level"`
+	} `json:"defaultConfiguration"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation struct {
+		ArtifactLocation struct {
+			URI string `json:"uri"`
+		} `json:"artifactLocation"`
+	} `json:"physicalLocation"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+// sarifLevel maps a lint severity to a SARIF result level
+func sarifLevel(severity string) string {
+	switch severity {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	case SeverityOff:
+		return "none"
+	}
+	return "note"
+}
+
+// WriteSARIF writes lint findings for the workbook at uri as a SARIF 2.1.0
+// log, the format code scanning tools in CI consume. Every registered rule
+// is described in the log, findings are located by their sheet and cell.
+func WriteSARIF(w io.Writer, uri string, findings []LintFinding) error {
+	driver := sarifDriver{
+		Name:           "excelmetadata",
+		InformationURI: "https://github.com/prongbang/excelmetadata",
+		Rules:          []sarifRule{},
+	}
+	for _, info := range LintRules() {
+		rule := sarifRule{ID: info.ID, ShortDescription: sarifMessage{Text: info.Description}}
+		rule.DefaultConfiguration.Level = sarifLevel(info.Severity)
+		driver.Rules = append(driver.Rules, rule)
+	}
+
+	results := []sarifResult{}
+	for _, finding := range findings {
+		location := sarifLocation{}
+		location.PhysicalLocation.ArtifactLocation.URI = uri
+		if name := finding.Location(); name != "" {
+			location.LogicalLocations = []sarifLogicalLocation{{FullyQualifiedName: name}}
+		}
+		results = append(results, sarifResult{
+			RuleID:    finding.Rule,
+			Level:     sarifLevel(finding.Severity),
+			Message:   sarifMessage{Text: finding.Message},
+			Locations: []sarifLocation{location},
+		})
+	}
+
+	log := sarifLog{
+		Version: "2.1.0",
+		Schema:  sarifSchema,
+		Runs:    []sarifRun{{Tool: sarifTool{Driver: driver}, Results: results}},
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(log); err != nil {
+		return fmt.Errorf("failed to write SARIF: %w", err)
+	}
+	return nil
+}
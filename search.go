@@ -0,0 +1,186 @@
+package excelmetadata
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Search targets
+const (
+	SearchValues       = "values"
+	SearchFormulas     = "formulas"
+	SearchComments     = "comments"
+	SearchDefinedNames = "names"
+	SearchHyperlinks   = "hyperlinks"
+	SearchProperties   = "properties"
+	SearchSheetNames   = "sheets"
+)
+
+// SearchTargets lists every search target
+var SearchTargets = []string{
+	SearchValues,
+	SearchFormulas,
+	SearchComments,
+	SearchDefinedNames,
+	SearchHyperlinks,
+	SearchProperties,
+	SearchSheetNames,
+}
+
+// SearchOptions configures how a pattern is matched
+type SearchOptions struct {
+	// Regex treats the pattern as a regular expression instead of literal text
+	Regex bool
+	// IgnoreCase matches without regard to case
+	IgnoreCase bool
+	// WholeWord only matches the pattern between word boundaries, words
+	// being letters, digits and underscores of any script
+	WholeWord bool
+	// Targets restricts the search to the given targets, all when empty
+	Targets []string
+}
+
+// SearchHit is a single match of a search pattern
+type SearchHit struct {
+	File   string `json:"file"`
+	Sheet  string `json:"sheet,omitempty"`
+	Cell   string `json:"cell,omitempty"`
+	Target string `json:"target"`
+	// Field names the property or defined name holding the match
+	Field string `json:"field,omitempty"`
+	Match string `json:"match"`
+	Text  string `json:"text"`
+}
+
+// Searcher matches a compiled pattern against metadata
+type Searcher struct {
+	re      *regexp.Regexp
+	targets map[string]bool
+	// wholeWord is checked on the matches, as \b only knows ASCII words
+	wholeWord bool
+}
+
+// NewSearcher compiles pattern with the given options
+func NewSearcher(pattern string, options *SearchOptions) (*Searcher, error) {
+	if pattern == "" {
+		return nil, fmt.Errorf("search pattern is empty")
+	}
+	if options == nil {
+		options = &SearchOptions{}
+	}
+
+	expr := pattern
+	if !options.Regex {
+		expr = regexp.QuoteMeta(pattern)
+	}
+	if options.IgnoreCase {
+		expr = "(?i)" + expr
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid search pattern: %w", err)
+	}
+
+	targets := map[string]bool{}
+	for _, target := range options.Targets {
+		target = strings.ToLower(strings.TrimSpace(target))
+		valid := false
+		for _, known := range SearchTargets {
+			valid = valid || known == target
+		}
+		if !valid {
+			return nil, fmt.Errorf("unknown search target %q", target)
+		}
+		targets[target] = true
+	}
+	if len(targets) == 0 {
+		for _, target := range SearchTargets {
+			targets[target] = true
+		}
+	}
+
+	return &Searcher{re: re, targets: targets, wholeWord: options.WholeWord}, nil
+}
+
+// Search reports every match of pattern in metadata
+func Search(metadata *Metadata, pattern string, options *SearchOptions) ([]SearchHit, error) {
+	s, err := NewSearcher(pattern, options)
+	if err != nil {
+		return nil, err
+	}
+	return s.Search(metadata), nil
+}
+
+// Search reports every match in metadata, in workbook order
+func (s *Searcher) Search(metadata *Metadata) []SearchHit {
+	var hits []SearchHit
+	match := func(hit SearchHit) {
+		if hit.Text == "" {
+			return
+		}
+		hit.File = metadata.Filename
+		for _, loc := range s.re.FindAllStringIndex(hit.Text, -1) {
+			if loc[0] == loc[1] || s.wholeWord && !wordBounded(hit.Text, loc[0], loc[1]) {
+				continue
+			}
+			hit.Match = hit.Text[loc[0]:loc[1]]
+			hits = append(hits, hit)
+		}
+	}
+
+	if s.targets[SearchProperties] {
+		for _, p := range propertyFields(metadata.Properties) {
+			match(SearchHit{Target: SearchProperties, Field: p.name, Text: p.value})
+		}
+	}
+
+	if s.targets[SearchDefinedNames] {
+		for _, dn := range metadata.DefinedNames {
+			sheet := dn.Scope
+			if sheet == "Workbook" {
+				sheet = ""
+			}
+			match(SearchHit{Target: SearchDefinedNames, Sheet: sheet, Field: dn.Name, Text: dn.Name})
+			match(SearchHit{Target: SearchDefinedNames, Sheet: sheet, Field: dn.Name, Text: dn.RefersTo})
+		}
+	}
+
+	for _, sheet := range metadata.Sheets {
+		if s.targets[SearchSheetNames] {
+			match(SearchHit{Target: SearchSheetNames, Sheet: sheet.Name, Text: sheet.Name})
+		}
+		for _, cell := range sheet.Cells {
+			if s.targets[SearchValues] {
+				match(SearchHit{Target: SearchValues, Sheet: sheet.Name, Cell: cell.Address, Text: reportValue(cell.Value)})
+			}
+			if s.targets[SearchFormulas] {
+				match(SearchHit{Target: SearchFormulas, Sheet: sheet.Name, Cell: cell.Address, Text: cell.Formula})
+			}
+			if s.targets[SearchHyperlinks] && cell.Hyperlink != nil {
+				match(SearchHit{Target: SearchHyperlinks, Sheet: sheet.Name, Cell: cell.Address, Text: cell.Hyperlink.Link})
+			}
+		}
+		if s.targets[SearchComments] {
+			for _, c := range sheet.Comments {
+				match(SearchHit{Target: SearchComments, Sheet: sheet.Name, Cell: c.Cell, Text: c.Text})
+			}
+		}
+	}
+
+	return hits
+}
+
+// wordBounded reports whether text[start:end] is neither preceded nor
+// followed by a letter, digit or underscore of any script
+func wordBounded(text string, start, end int) bool {
+	before, _ := utf8.DecodeLastRuneInString(text[:start])
+	after, _ := utf8.DecodeRuneInString(text[end:])
+	return !isWordRune(before) && !isWordRune(after)
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r)
+}
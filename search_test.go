@@ -0,0 +1,139 @@
+package excelmetadata_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/prongbang/excelmetadata"
+	"github.com/xuri/excelize/v2"
+)
+
+func hitKeys(hits []excelmetadata.SearchHit) []string {
+	var keys []string
+	for _, h := range hits {
+		keys = append(keys, h.Target+" "+h.Sheet+"!"+h.Cell+h.Field+" "+h.Match)
+	}
+	return keys
+}
+
+func TestSearch(t *testing.T) {
+	metadata := extract(t, newWorkbook(t, func(f *excelize.File) {
+		_ = f.SetDocProps(&excelize.DocProperties{Title: "Revenue forecast"})
+		_ = f.SetCellValue("Sheet1", "A1", "Revenue")
+		_ = f.SetCellValue("Sheet1", "A2", "revenue share, revenue total")
+		_ = f.SetCellValue("Sheet1", "A3", "Revenues")
+		_ = f.SetCellValue("Sheet1", "A4", "café crème, Straße")
+		_ = f.SetCellValue("Sheet1", "B1", 10)
+		_ = f.SetCellFormula("Sheet1", "B1", "SUM(Revenue)")
+		_ = f.SetCellValue("Sheet1", "C1", "link")
+		_ = f.SetCellHyperLink("Sheet1", "C1", "https://example.com/revenue", "External")
+		_ = f.AddComment("Sheet1", excelize.Comment{Cell: "A1", Author: "Ann", Text: "Check revenue numbers"})
+		_ = f.SetDefinedName(&excelize.DefinedName{Name: "Revenue", RefersTo: "Sheet1!$A$1"})
+	}))
+
+	tests := []struct {
+		name    string
+		pattern string
+		options *excelmetadata.SearchOptions
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:    "literal values",
+			pattern: "Revenue",
+			options: &excelmetadata.SearchOptions{Targets: []string{excelmetadata.SearchValues}},
+			want:    []string{"values Sheet1!A1 Revenue", "values Sheet1!A3 Revenue"},
+		},
+		{
+			name:    "ignore case reports every occurrence",
+			pattern: "revenue",
+			options: &excelmetadata.SearchOptions{IgnoreCase: true, Targets: []string{"values"}},
+			want: []string{
+				"values Sheet1!A1 Revenue",
+				"values Sheet1!A2 revenue",
+				"values Sheet1!A2 revenue",
+				"values Sheet1!A3 Revenue",
+			},
+		},
+		{
+			name:    "whole word",
+			pattern: "revenue",
+			options: &excelmetadata.SearchOptions{IgnoreCase: true, WholeWord: true, Targets: []string{"values"}},
+			want: []string{
+				"values Sheet1!A1 Revenue",
+				"values Sheet1!A2 revenue",
+				"values Sheet1!A2 revenue",
+			},
+		},
+		{
+			name:    "whole word outside ASCII",
+			pattern: `caf|crème|straße`,
+			options: &excelmetadata.SearchOptions{Regex: true, IgnoreCase: true, WholeWord: true, Targets: []string{"values"}},
+			want:    []string{"values Sheet1!A4 crème", "values Sheet1!A4 Straße"},
+		},
+		{
+			name:    "regex",
+			pattern: `revenue \w+`,
+			options: &excelmetadata.SearchOptions{Regex: true},
+			want: []string{
+				"values Sheet1!A2 revenue share",
+				"values Sheet1!A2 revenue total",
+				"comments Sheet1!A1 revenue numbers",
+			},
+		},
+		{
+			name:    "other targets",
+			pattern: "revenue",
+			options: &excelmetadata.SearchOptions{
+				IgnoreCase: true,
+				Targets: []string{
+					excelmetadata.SearchFormulas,
+					excelmetadata.SearchComments,
+					excelmetadata.SearchDefinedNames,
+					excelmetadata.SearchHyperlinks,
+					excelmetadata.SearchProperties,
+				},
+			},
+			want: []string{
+				"properties !title Revenue",
+				"names !Revenue Revenue",
+				"formulas Sheet1!B1 Revenue",
+				"hyperlinks Sheet1!C1 revenue",
+				"comments Sheet1!A1 revenue",
+			},
+		},
+		{
+			name:    "empty pattern",
+			pattern: "",
+			wantErr: true,
+		},
+		{
+			name:    "invalid regexp",
+			pattern: "(",
+			options: &excelmetadata.SearchOptions{Regex: true},
+			wantErr: true,
+		},
+		{
+			name:    "unknown target",
+			pattern: "x",
+			options: &excelmetadata.SearchOptions{Targets: []string{"charts"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hits, err := excelmetadata.Search(metadata, tt.pattern, tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Search() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := hitKeys(hits); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Search() = %q, want %q", got, tt.want)
+			}
+			for _, h := range hits {
+				if h.File != metadata.Filename {
+					t.Errorf("hit file = %q, want %q", h.File, metadata.Filename)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,360 @@
+package excelmetadata
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/xml"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// External link kinds
+const (
+	LinkWorkbook = "workbook"
+	LinkDDE      = "dde"
+	LinkOLE      = "ole"
+)
+
+// SecurityReport lists the parts of a workbook that can run code, reach out
+// to other files or hide content. Every sheet of the workbook is audited,
+// whatever the sheet selection.
+type SecurityReport struct {
+	// VBAProject is set for workbooks carrying macros
+	VBAProject *VBAProject `json:"vbaProject,omitempty"`
+	// ExternalLinks are links to other workbooks, DDE servers and OLE
+	// objects, refreshed when the workbook is opened
+	ExternalLinks []ExternalLink `json:"externalLinks,omitempty"`
+	// DDEFormulas are formulas launching a DDE conversation, such as
+	// =cmd|'/c calc'!A0
+	DDEFormulas []SecurityCell `json:"ddeFormulas,omitempty"`
+	// OLEObjects are embedded or linked OLE objects, including attachments
+	// not anchored to a sheet
+	OLEObjects []OLEObject `json:"oleObjects,omitempty"`
+	// Hyperlinks are links to targets other than https:// and mailto:
+	Hyperlinks []SecurityCell `json:"hyperlinks,omitempty"`
+	// HiddenSheets are the hidden and very hidden sheets
+	HiddenSheets []HiddenSheet `json:"hiddenSheets,omitempty"`
+}
+
+// VBAProject describes the macros of a workbook
+type VBAProject struct {
+	Part string `json:"part"`
+	// Modules are the names of the module streams of the project
+	Modules []string `json:"modules,omitempty"`
+	// Signed is set when the project carries a digital signature
+	Signed bool `json:"signed"`
+	// Error is set when the project could not be read
+	Error string `json:"error,omitempty"`
+}
+
+// ExternalLink is an external link part of the workbook
+type ExternalLink struct {
+	Part string `json:"part"`
+	// Kind is LinkWorkbook, LinkDDE or LinkOLE
+	Kind string `json:"kind"`
+	// Target is the linked file, or service|topic for DDE links
+	Target string `json:"target"`
+	// ProgID names the application of an OLE link
+	ProgID string `json:"progId,omitempty"`
+	// Items are the linked sheets of a workbook, or the items of a DDE or
+	// OLE link
+	Items []string `json:"items,omitempty"`
+}
+
+// SecurityCell is a cell flagged by the security audit, with its formula
+// or hyperlink target
+type SecurityCell struct {
+	Sheet  string `json:"sheet"`
+	Cell   string `json:"cell"`
+	Target string `json:"target"`
+}
+
+// OLEObject is an OLE object of a sheet or an embedded part
+type OLEObject struct {
+	// Sheet is empty for embedded parts no sheet refers to
+	Sheet  string `json:"sheet,omitempty"`
+	ProgID string `json:"progId,omitempty"`
+	// Part is the embedded part, Target the file of a linked object
+	Part   string `json:"part,omitempty"`
+	Target string `json:"target,omitempty"`
+	Size   int    `json:"size,omitempty"`
+	// Filename is the original name of a file attached as an OLE package
+	Filename string `json:"filename,omitempty"`
+}
+
+// HiddenSheet is a sheet that is not visible
+type HiddenSheet struct {
+	Name string `json:"name"`
+	// State is SheetHidden or SheetVeryHidden
+	State string `json:"state"`
+}
+
+// Findings returns the number of items in the report
+func (r *SecurityReport) Findings() int {
+	if r == nil {
+		return 0
+	}
+	n := len(r.ExternalLinks) + len(r.DDEFormulas) + len(r.OLEObjects) + len(r.Hyperlinks) + len(r.HiddenSheets)
+	if r.VBAProject != nil {
+		n++
+	}
+	return n
+}
+
+// ddeFormula matches DDE calls such as cmd|'/c calc'!A0, DDE() and
+// DDEAUTO()
+var ddeFormula = regexp.MustCompile(`(?i)(^|[^\w.])[\w.]+\|'?[^!]*'?!|\bDDE(AUTO)?\s*\(`)
+
+// AuditSecurity inspects the workbook package for macros, external links,
+// DDE and OLE objects, unsafe hyperlinks and hidden sheets
+func (e *Extractor) AuditSecurity() (*SecurityReport, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	report := &SecurityReport{}
+	workbook := e.workbookPart()
+	for _, rel := range e.readRelationships(workbook).Relationships {
+		part := resolvePart(workbook, rel.Target)
+		switch {
+		case strings.HasSuffix(rel.Type, "/vbaProject"):
+			report.VBAProject = e.auditVBAProject(part)
+		case strings.HasSuffix(rel.Type, "/externalLink"):
+			if link, ok := e.auditExternalLink(part); ok {
+				report.ExternalLinks = append(report.ExternalLinks, link)
+			}
+		}
+	}
+
+	anchored := make(map[string]bool)
+	for _, sheetName := range e.file.GetSheetList() {
+		if state := e.sheetState(sheetName); state != "" {
+			report.HiddenSheets = append(report.HiddenSheets, HiddenSheet{Name: sheetName, State: state})
+		}
+
+		// GetSheetDimension loads sheets kept in temporary files into the
+		// package before its parts are read
+		_, _ = e.file.GetSheetDimension(sheetName)
+		part, ok := e.sheetParts()[sheetName]
+		if !ok {
+			continue
+		}
+		formulas := e.sheetFormulas(sheetName)
+		for _, cell := range sortedCells(formulas) {
+			if formula, err := e.file.GetCellFormula(sheetName, cell); err == nil && ddeFormula.MatchString(formula) {
+				report.DDEFormulas = append(report.DDEFormulas, SecurityCell{Sheet: sheetName, Cell: cell, Target: formula})
+			}
+		}
+		hyperlinks, objects := e.auditSheetPart(sheetName, part)
+		report.Hyperlinks = append(report.Hyperlinks, hyperlinks...)
+		for _, object := range objects {
+			if object.Part != "" {
+				anchored[object.Part] = true
+			}
+			report.OLEObjects = append(report.OLEObjects, object)
+		}
+	}
+
+	// Embedded parts no sheet anchors, e.g. attachments of charts
+	var embeddings []string
+	e.file.Pkg.Range(func(key, _ interface{}) bool {
+		if name, ok := key.(string); ok && strings.HasPrefix(name, "xl/embeddings/") && !anchored[name] {
+			embeddings = append(embeddings, name)
+		}
+		return true
+	})
+	sort.Strings(embeddings)
+	for _, part := range embeddings {
+		report.OLEObjects = append(report.OLEObjects, e.embeddedObject(OLEObject{Part: part}))
+	}
+	return report, nil
+}
+
+// auditVBAProject lists the modules of a VBA project part
+func (e *Extractor) auditVBAProject(part string) *VBAProject {
+	project := &VBAProject{Part: part}
+	for _, rel := range e.readRelationships(part).Relationships {
+		if strings.HasSuffix(rel.Type, "/vbaProjectSignature") || strings.HasSuffix(rel.Type, "/vbaProjectSignatureAgile") {
+			project.Signed = true
+		}
+	}
+
+	cfb, err := parseCFB(e.readPart(part))
+	if err != nil {
+		project.Error = err.Error()
+		return project
+	}
+	storage, ok := cfb.find("VBA")
+	if !ok || storage.kind != cfbStorage {
+		project.Error = "missing VBA storage"
+		return project
+	}
+	// Besides one stream per module, the VBA storage holds the dir stream,
+	// the _VBA_PROJECT stream and __SRP_n performance caches
+	for _, entry := range cfb.children(storage) {
+		name := entry.name
+		if entry.kind != cfbStream || strings.EqualFold(name, "dir") || strings.EqualFold(name, "_VBA_PROJECT") || strings.HasPrefix(name, "__SRP_") {
+			continue
+		}
+		project.Modules = append(project.Modules, name)
+	}
+	return project
+}
+
+// auditExternalLink describes an external link part
+func (e *Extractor) auditExternalLink(part string) (ExternalLink, bool) {
+	var content struct {
+		Book *struct {
+			RID        string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+			SheetNames []struct {
+				Val string `xml:"val,attr"`
+			} `xml:"sheetNames>sheetName"`
+		} `xml:"externalBook"`
+		DDE *struct {
+			Service string `xml:"ddeService,attr"`
+			Topic   string `xml:"ddeTopic,attr"`
+			Items   []struct {
+				Name string `xml:"name,attr"`
+			} `xml:"ddeItems>ddeItem"`
+		} `xml:"ddeLink"`
+		OLE *struct {
+			RID    string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+			ProgID string `xml:"progId,attr"`
+			Items  []struct {
+				Name string `xml:"name,attr"`
+			} `xml:"oleItems>oleItem"`
+		} `xml:"oleLink"`
+	}
+	if err := xml.Unmarshal(e.readPart(part), &content); err != nil {
+		return ExternalLink{}, false
+	}
+
+	targets := make(map[string]string)
+	for _, rel := range e.readRelationships(part).Relationships {
+		targets[rel.ID] = rel.Target
+	}
+	link := ExternalLink{Part: part}
+	switch {
+	case content.Book != nil:
+		link.Kind, link.Target = LinkWorkbook, targets[content.Book.RID]
+		for _, sheet := range content.Book.SheetNames {
+			link.Items = append(link.Items, sheet.Val)
+		}
+	case content.DDE != nil:
+		link.Kind, link.Target = LinkDDE, content.DDE.Service+"|"+content.DDE.Topic
+		for _, item := range content.DDE.Items {
+			link.Items = append(link.Items, item.Name)
+		}
+	case content.OLE != nil:
+		link.Kind, link.Target, link.ProgID = LinkOLE, targets[content.OLE.RID], content.OLE.ProgID
+		for _, item := range content.OLE.Items {
+			link.Items = append(link.Items, item.Name)
+		}
+	default:
+		return ExternalLink{}, false
+	}
+	return link, true
+}
+
+// auditSheetPart returns the unsafe hyperlinks and the OLE objects of a
+// worksheet part
+func (e *Extractor) auditSheetPart(sheetName, part string) ([]SecurityCell, []OLEObject) {
+	rels := make(map[string]struct{ typ, target, mode string })
+	for _, rel := range e.readRelationships(part).Relationships {
+		rels[rel.ID] = struct{ typ, target, mode string }{rel.Type, rel.Target, rel.TargetMode}
+	}
+
+	var (
+		hyperlinks []SecurityCell
+		objects    []OLEObject
+		seen       = make(map[string]bool)
+	)
+	decoder := xml.NewDecoder(bytes.NewReader(e.readPart(part)))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			break
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch start.Name.Local {
+		case "hyperlink":
+			rel, ok := rels[relationshipID(start)]
+			if !ok || !unsafeHyperlink(rel.target) {
+				continue
+			}
+			hyperlinks = append(hyperlinks, SecurityCell{Sheet: sheetName, Cell: attrValue(start, "ref"), Target: rel.target})
+		case "oleObject":
+			// Objects are repeated in the fallback of mc:AlternateContent
+			rID := relationshipID(start)
+			key := rID + "|" + attrValue(start, "shapeId")
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			object := OLEObject{Sheet: sheetName, ProgID: attrValue(start, "progId")}
+			if rel, ok := rels[rID]; ok {
+				if rel.mode == "External" {
+					object.Target = rel.target
+				} else {
+					object.Part = resolvePart(part, rel.target)
+					object = e.embeddedObject(object)
+				}
+			}
+			objects = append(objects, object)
+		}
+	}
+	return hyperlinks, objects
+}
+
+// embeddedObject fills the size of an embedded part and the file name of an
+// OLE package attachment
+func (e *Extractor) embeddedObject(object OLEObject) OLEObject {
+	content := e.readPart(object.Part)
+	object.Size = len(content)
+	if cfb, err := parseCFB(content); err == nil {
+		if entry, ok := cfb.find("\x01Ole10Native"); ok {
+			if data, err := cfb.stream(entry); err == nil {
+				object.Filename = ole10NativeLabel(data)
+			}
+		}
+	}
+	if object.Filename == "" && !strings.HasSuffix(object.Part, ".bin") {
+		// Office documents are embedded as they are
+		object.Filename = path.Base(object.Part)
+	}
+	return object
+}
+
+// ole10NativeLabel returns the file name stored in an Ole10Native stream:
+// a 4 byte size, 2 bytes of flags and the NUL terminated label
+func ole10NativeLabel(data []byte) string {
+	if len(data) < 7 || int(binary.LittleEndian.Uint32(data)) > len(data) {
+		return ""
+	}
+	label := data[6:]
+	if i := bytes.IndexByte(label, 0); i >= 0 {
+		return string(label[:i])
+	}
+	return ""
+}
+
+// relationshipID returns the r:id attribute of an element
+func relationshipID(start xml.StartElement) string {
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "id" && strings.HasSuffix(attr.Name.Space, "/relationships") {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// unsafeHyperlink reports whether a hyperlink target is anything but an
+// https:// or mailto: link
+func unsafeHyperlink(target string) bool {
+	lower := strings.ToLower(strings.TrimSpace(target))
+	return !strings.HasPrefix(lower, "https://") && !strings.HasPrefix(lower, "mailto:")
+}
@@ -0,0 +1,339 @@
+package excelmetadata_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/prongbang/excelmetadata"
+	"github.com/xuri/excelize/v2"
+)
+
+// compoundFile builds a compound file (OLE2) holding streams at slash
+// separated paths, with every stream stored in the mini stream
+func compoundFile(streams map[string][]byte) []byte {
+	const (
+		free, endOfChain, fatSector = 0xFFFFFFFF, 0xFFFFFFFE, 0xFFFFFFFD
+		noStream                    = 0xFFFFFFFF
+	)
+	type entry struct {
+		name     string
+		kind     byte
+		data     []byte
+		start    uint32
+		children []int
+	}
+	entries := []*entry{{name: "Root Entry", kind: 5}}
+	index := map[string]int{"": 0}
+	paths := make([]string, 0, len(streams))
+	for p := range streams {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	for _, p := range paths {
+		parent := ""
+		parts := strings.Split(p, "/")
+		for i, name := range parts {
+			key := strings.Join(parts[:i+1], "/")
+			if _, ok := index[key]; !ok {
+				e := &entry{name: name, kind: 1}
+				if i == len(parts)-1 {
+					e.kind, e.data = 2, streams[p]
+				}
+				index[key] = len(entries)
+				entries[index[parent]].children = append(entries[index[parent]].children, len(entries))
+				entries = append(entries, e)
+			}
+			parent = key
+		}
+	}
+
+	// Stream data goes to the mini stream in 64 byte sectors
+	var ministream []byte
+	var miniFAT []uint32
+	for _, e := range entries {
+		e.start = endOfChain
+		if len(e.data) == 0 {
+			continue
+		}
+		e.start = uint32(len(miniFAT))
+		n := (len(e.data) + 63) / 64
+		for i := 0; i < n; i++ {
+			next := uint32(len(miniFAT) + 1)
+			if i == n-1 {
+				next = endOfChain
+			}
+			miniFAT = append(miniFAT, next)
+		}
+		ministream = append(ministream, e.data...)
+		ministream = append(ministream, make([]byte, n*64-len(e.data))...)
+	}
+
+	// Sector 0 is the FAT, then the directory, the mini FAT and the mini
+	// stream, each a chain of consecutive sectors
+	var fat []uint32
+	appendChain := func(sectors int) uint32 {
+		if sectors == 0 {
+			return endOfChain
+		}
+		start := uint32(len(fat))
+		for i := 0; i < sectors; i++ {
+			next := uint32(len(fat) + 1)
+			if i == sectors-1 {
+				next = endOfChain
+			}
+			fat = append(fat, next)
+		}
+		return start
+	}
+	fat = append(fat, fatSector)
+	dirStart := appendChain((len(entries) + 3) / 4)
+	miniFATStart := appendChain((len(miniFAT)*4 + 511) / 512)
+	ministreamStart := appendChain((len(ministream) + 511) / 512)
+	entries[0].start = ministreamStart
+
+	sector := func(data []byte) []byte {
+		return append(data, make([]byte, (512-len(data)%512)%512)...)
+	}
+	var buf bytes.Buffer
+	header := make([]byte, 512)
+	copy(header, []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1})
+	binary.LittleEndian.PutUint16(header[0x18:], 0x3E)
+	binary.LittleEndian.PutUint16(header[0x1A:], 3)
+	binary.LittleEndian.PutUint16(header[0x1C:], 0xFFFE)
+	binary.LittleEndian.PutUint16(header[0x1E:], 9)
+	binary.LittleEndian.PutUint16(header[0x20:], 6)
+	binary.LittleEndian.PutUint32(header[0x2C:], 1)
+	binary.LittleEndian.PutUint32(header[0x30:], dirStart)
+	binary.LittleEndian.PutUint32(header[0x38:], 4096)
+	binary.LittleEndian.PutUint32(header[0x3C:], miniFATStart)
+	binary.LittleEndian.PutUint32(header[0x40:], uint32((len(miniFAT)*4+511)/512))
+	binary.LittleEndian.PutUint32(header[0x44:], endOfChain)
+	for i := 0; i < 109; i++ {
+		binary.LittleEndian.PutUint32(header[0x4C+4*i:], free)
+	}
+	binary.LittleEndian.PutUint32(header[0x4C:], 0)
+	buf.Write(header)
+
+	fatData := make([]byte, 512)
+	for i := range fatData {
+		fatData[i] = 0xFF
+	}
+	for i, next := range fat {
+		binary.LittleEndian.PutUint32(fatData[4*i:], next)
+	}
+	buf.Write(fatData)
+
+	var dir []byte
+	for _, e := range entries {
+		raw := make([]byte, 128)
+		name := utf16.Encode([]rune(e.name))
+		for i, r := range name {
+			binary.LittleEndian.PutUint16(raw[2*i:], r)
+		}
+		binary.LittleEndian.PutUint16(raw[64:], uint16(2*len(name)+2))
+		raw[66] = e.kind
+		binary.LittleEndian.PutUint32(raw[68:], noStream)
+		binary.LittleEndian.PutUint32(raw[72:], noStream)
+		binary.LittleEndian.PutUint32(raw[76:], noStream)
+		if len(e.children) > 0 {
+			binary.LittleEndian.PutUint32(raw[76:], uint32(e.children[0]))
+		}
+		binary.LittleEndian.PutUint32(raw[116:], e.start)
+		size := len(e.data)
+		if e.kind == 5 {
+			size = len(ministream)
+		}
+		binary.LittleEndian.PutUint64(raw[120:], uint64(size))
+		dir = append(dir, raw...)
+	}
+	// Siblings are chained through their right pointers
+	for _, e := range entries {
+		for i := 0; i+1 < len(e.children); i++ {
+			binary.LittleEndian.PutUint32(dir[e.children[i]*128+72:], uint32(e.children[i+1]))
+		}
+	}
+	buf.Write(sector(dir))
+
+	var miniFATData []byte
+	for _, next := range miniFAT {
+		miniFATData = binary.LittleEndian.AppendUint32(miniFATData, next)
+	}
+	buf.Write(sector(miniFATData))
+	buf.Write(sector(ministream))
+	return buf.Bytes()
+}
+
+const relationshipsNS = "http://schemas.openxmlformats.org/officeDocument/2006/relationships"
+
+func TestAuditSecurity(t *testing.T) {
+	f := excelize.NewFile()
+	vba := compoundFile(map[string][]byte{
+		"VBA/dir":          nil,
+		"VBA/_VBA_PROJECT": nil,
+		"VBA/__SRP_0":      nil,
+		"VBA/ThisWorkbook": nil,
+		"VBA/Module1":      nil,
+		"PROJECT":          nil,
+	})
+	if err := f.AddVBAProject(vba); err != nil {
+		t.Fatal(err)
+	}
+	for _, sheet := range []string{"Hidden", "Secret"} {
+		if _, err := f.NewSheet(sheet); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_ = f.SetSheetVisible("Hidden", false)
+	_ = f.SetSheetVisible("Secret", false, true)
+
+	_ = f.SetCellHyperLink("Sheet1", "A1", "http://example.com", "External")
+	_ = f.SetCellHyperLink("Sheet1", "B1", "https://example.com", "External")
+	_ = f.SetCellHyperLink("Sheet1", "C1", "file:///C:/Temp/run.exe", "External")
+	_ = f.SetCellHyperLink("Sheet1", "D1", "Hidden!A1", "Location")
+	_ = f.SetCellValue("Sheet1", "E1", 0)
+	_ = f.SetCellFormula("Sheet1", "E1", "cmd|' /C calc'!A0")
+	_ = f.SetCellValue("Sheet1", "F1", 0)
+	_ = f.SetCellFormula("Sheet1", "F1", "SUM(A1:A2)")
+
+	filename := filepath.Join(t.TempDir(), "book.xlsm")
+	if err := f.SaveAs(filename); err != nil {
+		t.Fatal(err)
+	}
+	_ = f.Close()
+
+	// An attachment packaged as an OLE object, a link to another workbook
+	// and a DDE link
+	native := []byte{0, 0, 0, 0, 2, 0}
+	native = append(native, "invoice.exe\x00C:\\Temp\\invoice.exe\x00"...)
+	binary.LittleEndian.PutUint32(native, uint32(len(native)-4))
+	addParts(t, filename, map[string]string{
+		"xl/embeddings/oleObject1.bin":                  string(compoundFile(map[string][]byte{"\x01Ole10Native": native})),
+		"xl/embeddings/Microsoft_Word_Document.docx":    "PK",
+		"xl/externalLinks/externalLink1.xml":            `<externalLink xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="` + relationshipsNS + `"><externalBook r:id="rId1"><sheetNames><sheetName val="Rates"/></sheetNames></externalBook></externalLink>`,
+		"xl/externalLinks/_rels/externalLink1.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="` + relationshipsNS + `/externalLinkPath" Target="file:///\\server\share\Rates.xlsx" TargetMode="External"/></Relationships>`,
+		"xl/externalLinks/externalLink2.xml":            `<externalLink xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><ddeLink ddeService="cmd" ddeTopic="/c calc"><ddeItems><ddeItem name="A0"/></ddeItems></ddeLink></externalLink>`,
+	})
+	rewritePart(t, filename, "xl/_rels/workbook.xml.rels", "</Relationships>",
+		`<Relationship Id="rIdLink1" Type="`+relationshipsNS+`/externalLink" Target="externalLinks/externalLink1.xml"/>`+
+			`<Relationship Id="rIdLink2" Type="`+relationshipsNS+`/externalLink" Target="externalLinks/externalLink2.xml"/></Relationships>`)
+	rewritePart(t, filename, "xl/worksheets/_rels/sheet1.xml.rels", "</Relationships>",
+		`<Relationship Id="rIdOle" Type="`+relationshipsNS+`/oleObject" Target="../embeddings/oleObject1.bin"/></Relationships>`)
+	rewritePart(t, filename, "xl/worksheets/sheet1.xml", "</worksheet>",
+		`<oleObjects><oleObject progId="Package" shapeId="1025" r:id="rIdOle"/></oleObjects></worksheet>`)
+
+	metadata := extractWithOptions(t, filename, func(o *excelmetadata.Options) { o.AuditSecurity = true })
+	report := metadata.Security
+	if report == nil {
+		t.Fatal("security report missing")
+	}
+
+	if want := (&excelmetadata.VBAProject{Part: "xl/vbaProject.bin", Modules: []string{"Module1", "ThisWorkbook"}}); !reflect.DeepEqual(report.VBAProject, want) {
+		t.Errorf("VBA project = %+v, want %+v", report.VBAProject, want)
+	}
+	wantLinks := []excelmetadata.ExternalLink{
+		{Part: "xl/externalLinks/externalLink1.xml", Kind: excelmetadata.LinkWorkbook, Target: `file:///\\server\share\Rates.xlsx`, Items: []string{"Rates"}},
+		{Part: "xl/externalLinks/externalLink2.xml", Kind: excelmetadata.LinkDDE, Target: "cmd|/c calc", Items: []string{"A0"}},
+	}
+	if !reflect.DeepEqual(report.ExternalLinks, wantLinks) {
+		t.Errorf("external links = %+v\nwant %+v", report.ExternalLinks, wantLinks)
+	}
+	if want := []excelmetadata.SecurityCell{{Sheet: "Sheet1", Cell: "E1", Target: "cmd|' /C calc'!A0"}}; !reflect.DeepEqual(report.DDEFormulas, want) {
+		t.Errorf("DDE formulas = %+v, want %+v", report.DDEFormulas, want)
+	}
+	wantHyperlinks := []excelmetadata.SecurityCell{
+		{Sheet: "Sheet1", Cell: "A1", Target: "http://example.com"},
+		{Sheet: "Sheet1", Cell: "C1", Target: "file:///C:/Temp/run.exe"},
+	}
+	if !reflect.DeepEqual(report.Hyperlinks, wantHyperlinks) {
+		t.Errorf("hyperlinks = %+v, want %+v", report.Hyperlinks, wantHyperlinks)
+	}
+	wantObjects := []excelmetadata.OLEObject{
+		{Sheet: "Sheet1", ProgID: "Package", Part: "xl/embeddings/oleObject1.bin", Size: len(compoundFile(map[string][]byte{"\x01Ole10Native": native})), Filename: "invoice.exe"},
+		{Part: "xl/embeddings/Microsoft_Word_Document.docx", Size: 2, Filename: "Microsoft_Word_Document.docx"},
+	}
+	if !reflect.DeepEqual(report.OLEObjects, wantObjects) {
+		t.Errorf("OLE objects = %+v\nwant %+v", report.OLEObjects, wantObjects)
+	}
+	wantHidden := []excelmetadata.HiddenSheet{{Name: "Hidden", State: excelmetadata.SheetHidden}, {Name: "Secret", State: excelmetadata.SheetVeryHidden}}
+	if !reflect.DeepEqual(report.HiddenSheets, wantHidden) {
+		t.Errorf("hidden sheets = %+v, want %+v", report.HiddenSheets, wantHidden)
+	}
+	if got := report.Findings(); got != 10 {
+		t.Errorf("findings = %d, want 10", got)
+	}
+}
+
+func TestAuditSecurityClean(t *testing.T) {
+	filename := newWorkbook(t, func(f *excelize.File) {
+		_ = f.SetCellValue("Sheet1", "A1", "safe")
+		_ = f.SetCellHyperLink("Sheet1", "A1", "https://example.com", "External")
+	})
+	if metadata := extract(t, filename); metadata.Security != nil {
+		t.Errorf("security report without AuditSecurity: %+v", metadata.Security)
+	}
+
+	metadata := extractWithOptions(t, filename, func(o *excelmetadata.Options) { o.AuditSecurity = true })
+	if n := metadata.Security.Findings(); n != 0 {
+		t.Errorf("clean workbook has %d findings: %+v", n, metadata.Security)
+	}
+}
+
+func TestAuditMalformedVBAProject(t *testing.T) {
+	header := func(size int, setup func(data []byte)) []byte {
+		data := make([]byte, size)
+		copy(data, []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1})
+		binary.LittleEndian.PutUint16(data[0x1E:], 9)
+		setup(data)
+		return data
+	}
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"huge FAT count", header(1024, func(data []byte) {
+			binary.LittleEndian.PutUint32(data[0x2C:], 0x7FFFFFFF)
+		})},
+		// The first DIFAT sector points back to itself
+		{"looping DIFAT", header(512*1000, func(data []byte) {
+			binary.LittleEndian.PutUint32(data[0x2C:], 0x7FFFFFFF)
+			binary.LittleEndian.PutUint32(data[0x44:], 0)
+			binary.LittleEndian.PutUint32(data[512+508:], 0)
+		})},
+		{"truncated", header(512, func(data []byte) {
+			binary.LittleEndian.PutUint32(data[0x2C:], 1)
+			binary.LittleEndian.PutUint32(data[0x4C:], 7)
+		})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := excelize.NewFile()
+			if err := f.AddVBAProject(tt.data); err != nil {
+				t.Fatal(err)
+			}
+			filename := filepath.Join(t.TempDir(), "book.xlsm")
+			if err := f.SaveAs(filename); err != nil {
+				t.Fatal(err)
+			}
+			_ = f.Close()
+
+			extractor, err := excelmetadata.New(filename, excelmetadata.DefaultOptions())
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer extractor.Close()
+			report, err := extractor.AuditSecurity()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if report.VBAProject == nil || report.VBAProject.Error == "" {
+				t.Errorf("VBAProject = %+v, want a parse error", report.VBAProject)
+			}
+		})
+	}
+}
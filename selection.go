@@ -0,0 +1,251 @@
+package excelmetadata
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// sheetPattern matches sheet names. Patterns wrapped in slashes are regular
+// expressions, patterns containing *, ? or [ are globs and anything else is
+// an exact, case-insensitive sheet name.
+type sheetPattern struct {
+	text string
+	re   *regexp.Regexp
+	glob bool
+}
+
+func parseSheetPattern(pattern string) (sheetPattern, error) {
+	p := sheetPattern{text: pattern}
+	switch {
+	case len(pattern) >= 2 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/"):
+		re, err := regexp.Compile(pattern[1 : len(pattern)-1])
+		if err != nil {
+			return p, fmt.Errorf("invalid sheet pattern %q: %w", pattern, err)
+		}
+		p.re = re
+	case strings.ContainsAny(pattern, "*?["):
+		if _, err := path.Match(pattern, ""); err != nil {
+			return p, fmt.Errorf("invalid sheet pattern %q: %w", pattern, err)
+		}
+		p.glob = true
+	}
+	return p, nil
+}
+
+// literal reports whether the pattern names a single sheet
+func (p sheetPattern) literal() bool {
+	return p.re == nil && !p.glob
+}
+
+func (p sheetPattern) match(sheetName string) bool {
+	switch {
+	case p.re != nil:
+		return p.re.MatchString(sheetName)
+	case p.glob:
+		ok, _ := path.Match(strings.ToLower(p.text), strings.ToLower(sheetName))
+		return ok
+	default:
+		return strings.EqualFold(p.text, sheetName)
+	}
+}
+
+// cellRange is an inclusive rectangle of cells
+type cellRange struct {
+	fromCol, fromRow int
+	toCol, toRow     int
+}
+
+// parseCellRange parses a reference such as A1:F500, A1, A:F or 1:500
+func parseCellRange(ref string) (cellRange, error) {
+	ref = strings.ReplaceAll(ref, "$", "")
+	parts := strings.SplitN(ref, ":", 2)
+	fromCol, fromRow, err := rangeCorner(parts[0], false)
+	if err != nil {
+		return cellRange{}, fmt.Errorf("invalid range %q: %w", ref, err)
+	}
+	toCol, toRow := fromCol, fromRow
+	if len(parts) == 2 {
+		if toCol, toRow, err = rangeCorner(parts[1], true); err != nil {
+			return cellRange{}, fmt.Errorf("invalid range %q: %w", ref, err)
+		}
+	} else if !strings.ContainsAny(ref, "0123456789") || strings.Trim(ref, "0123456789") == "" {
+		return cellRange{}, fmt.Errorf("invalid range %q", ref)
+	}
+	if fromCol > toCol {
+		fromCol, toCol = toCol, fromCol
+	}
+	if fromRow > toRow {
+		fromRow, toRow = toRow, fromRow
+	}
+	return cellRange{fromCol: fromCol, fromRow: fromRow, toCol: toCol, toRow: toRow}, nil
+}
+
+// rangeCorner parses a corner of a range, where a column (A) or a row (5)
+// alone spans the whole sheet
+func rangeCorner(corner string, end bool) (col, row int, err error) {
+	switch {
+	case corner != "" && strings.Trim(corner, "0123456789") == "":
+		row, err = strconv.Atoi(corner)
+		if err == nil && (row < 1 || row > excelize.TotalRows) {
+			err = excelize.ErrMaxRows
+		}
+		col = 1
+		if end {
+			col = excelize.MaxColumns
+		}
+		return col, row, err
+	case corner != "" && !strings.ContainsAny(corner, "0123456789"):
+		col, err = excelize.ColumnNameToNumber(corner)
+		row = 1
+		if end {
+			row = excelize.TotalRows
+		}
+		return col, row, err
+	}
+	return excelize.CellNameToCoordinates(corner)
+}
+
+// String returns the range as a reference such as A1:F500
+func (r cellRange) String() string {
+	from, _ := excelize.CoordinatesToCellName(r.fromCol, r.fromRow)
+	to, _ := excelize.CoordinatesToCellName(r.toCol, r.toRow)
+	return from + ":" + to
+}
+
+func (r *cellRange) contains(col, row int) bool {
+	return r == nil || (col >= r.fromCol && col <= r.toCol && row >= r.fromRow && row <= r.toRow)
+}
+
+func (r *cellRange) containsCell(cell string) bool {
+	if r == nil {
+		return true
+	}
+	col, row, err := excelize.CellNameToCoordinates(cell)
+	return err == nil && r.contains(col, row)
+}
+
+func (r *cellRange) containsCol(col int) bool {
+	return r == nil || (col >= r.fromCol && col <= r.toCol)
+}
+
+// covers reports whether every cell of other is inside the range
+func (r *cellRange) covers(other cellRange) bool {
+	return other.fromCol >= r.fromCol && other.toCol <= r.toCol &&
+		other.fromRow >= r.fromRow && other.toRow <= r.toRow
+}
+
+// overlaps reports whether any of the space separated references in refs
+// shares a cell with the range
+func (r *cellRange) overlaps(refs string) bool {
+	if r == nil {
+		return true
+	}
+	for _, ref := range strings.Fields(refs) {
+		other, err := parseCellRange(ref)
+		if err != nil {
+			continue
+		}
+		if other.fromCol <= r.toCol && other.toCol >= r.fromCol &&
+			other.fromRow <= r.toRow && other.toRow >= r.fromRow {
+			return true
+		}
+	}
+	return false
+}
+
+// sheetSelection holds the parsed Sheets, ExcludeSheets and Ranges options
+type sheetSelection struct {
+	include    []sheetPattern
+	exclude    []sheetPattern
+	skipHidden bool
+	ranges     map[string]*cellRange
+}
+
+// newSheetSelection validates the selection options against the sheets of
+// the workbook
+func newSheetSelection(options *Options, sheets []string) (*sheetSelection, error) {
+	s := &sheetSelection{
+		skipHidden: options.SkipHiddenSheets,
+		ranges:     make(map[string]*cellRange),
+	}
+
+	exists := func(name string) (string, bool) {
+		for _, sheet := range sheets {
+			if strings.EqualFold(sheet, name) {
+				return sheet, true
+			}
+		}
+		return "", false
+	}
+
+	for _, pattern := range options.Sheets {
+		p, err := parseSheetPattern(pattern)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := exists(pattern); p.literal() && !ok {
+			return nil, fmt.Errorf("sheet %q not found", pattern)
+		}
+		s.include = append(s.include, p)
+	}
+	for _, pattern := range options.ExcludeSheets {
+		p, err := parseSheetPattern(pattern)
+		if err != nil {
+			return nil, err
+		}
+		s.exclude = append(s.exclude, p)
+	}
+
+	for _, ref := range options.Ranges {
+		i := strings.LastIndex(ref, "!")
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid range %q: want Sheet!A1:B2", ref)
+		}
+		name := strings.Trim(ref[:i], "'")
+		sheet, ok := exists(name)
+		if !ok {
+			return nil, fmt.Errorf("sheet %q not found", name)
+		}
+		if _, ok := s.ranges[sheet]; ok {
+			return nil, fmt.Errorf("more than one range for sheet %q", sheet)
+		}
+		r, err := parseCellRange(ref[i+1:])
+		if err != nil {
+			return nil, err
+		}
+		s.ranges[sheet] = &r
+	}
+
+	return s, nil
+}
+
+// selects reports whether a sheet is extracted
+func (s *sheetSelection) selects(sheetName string, visible bool) bool {
+	if s.skipHidden && !visible {
+		return false
+	}
+	for _, p := range s.exclude {
+		if p.match(sheetName) {
+			return false
+		}
+	}
+	if len(s.include) == 0 {
+		return true
+	}
+	for _, p := range s.include {
+		if p.match(sheetName) {
+			return true
+		}
+	}
+	return false
+}
+
+// rangeOf returns the cell range to extract from a sheet, nil for all cells
+func (s *sheetSelection) rangeOf(sheetName string) *cellRange {
+	return s.ranges[sheetName]
+}
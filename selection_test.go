@@ -0,0 +1,183 @@
+package excelmetadata_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/prongbang/excelmetadata"
+	"github.com/xuri/excelize/v2"
+)
+
+func sheetNames(metadata *excelmetadata.Metadata) []string {
+	var names []string
+	for _, sheet := range metadata.Sheets {
+		names = append(names, sheet.Name)
+	}
+	return names
+}
+
+func TestSelection(t *testing.T) {
+	filename := newWorkbook(t, func(f *excelize.File) {
+		for _, sheet := range []string{"Q1", "Q2", "Q3", "Summary", "Data"} {
+			if _, err := f.NewSheet(sheet); err != nil {
+				t.Fatal(err)
+			}
+			_ = f.SetCellValue(sheet, "A1", sheet)
+		}
+		_ = f.SetSheetVisible("Q3", false)
+		_ = f.SetDefinedName(&excelize.DefinedName{Name: "Local", RefersTo: "Q1!$A$1", Scope: "Q1"})
+		_ = f.SetDefinedName(&excelize.DefinedName{Name: "Global", RefersTo: "Data!$A$1"})
+
+		for row := 1; row <= 10; row++ {
+			for col := 1; col <= 8; col++ {
+				cell, _ := excelize.CoordinatesToCellName(col, row)
+				_ = f.SetCellValue("Data", cell, row*col)
+			}
+		}
+		_ = f.MergeCell("Data", "B2", "C3")
+		_ = f.MergeCell("Data", "G9", "H10")
+		_ = f.AddComment("Data", excelize.Comment{Cell: "B2", Author: "QA", Text: "inside"})
+		_ = f.AddComment("Data", excelize.Comment{Cell: "H10", Author: "QA", Text: "outside"})
+		dv := excelize.NewDataValidation(true)
+		dv.Sqref = "F1:F10"
+		_ = dv.SetRange(1, 10, excelize.DataValidationTypeWhole, excelize.DataValidationOperatorBetween)
+		_ = f.AddDataValidation("Data", dv)
+	})
+
+	tests := []struct {
+		name      string
+		configure func(*excelmetadata.Options)
+		want      []string
+		wantErr   bool
+	}{
+		{
+			name:      "all sheets",
+			configure: func(o *excelmetadata.Options) {},
+			want:      []string{"Sheet1", "Q1", "Q2", "Q3", "Summary", "Data"},
+		},
+		{
+			name:      "names are case insensitive",
+			configure: func(o *excelmetadata.Options) { o.Sheets = []string{"data", "Summary"} },
+			want:      []string{"Summary", "Data"},
+		},
+		{
+			name:      "glob",
+			configure: func(o *excelmetadata.Options) { o.Sheets = []string{"q*"} },
+			want:      []string{"Q1", "Q2", "Q3"},
+		},
+		{
+			name:      "regexp",
+			configure: func(o *excelmetadata.Options) { o.Sheets = []string{"/^Q[12]$/"} },
+			want:      []string{"Q1", "Q2"},
+		},
+		{
+			name: "exclude",
+			configure: func(o *excelmetadata.Options) {
+				o.Sheets = []string{"Q*"}
+				o.ExcludeSheets = []string{"Q2"}
+			},
+			want: []string{"Q1", "Q3"},
+		},
+		{
+			name:      "skip hidden",
+			configure: func(o *excelmetadata.Options) { o.SkipHiddenSheets = true },
+			want:      []string{"Sheet1", "Q1", "Q2", "Summary", "Data"},
+		},
+		{
+			name:      "missing sheet",
+			configure: func(o *excelmetadata.Options) { o.Sheets = []string{"Missing"} },
+			wantErr:   true,
+		},
+		{
+			name:      "invalid regexp",
+			configure: func(o *excelmetadata.Options) { o.Sheets = []string{"/([/"} },
+			wantErr:   true,
+		},
+		{
+			name:      "invalid glob",
+			configure: func(o *excelmetadata.Options) { o.Sheets = []string{"[x"} },
+			wantErr:   true,
+		},
+		{
+			name:      "range without sheet",
+			configure: func(o *excelmetadata.Options) { o.Ranges = []string{"A1:B2"} },
+			wantErr:   true,
+		},
+		{
+			name:      "range on a missing sheet",
+			configure: func(o *excelmetadata.Options) { o.Ranges = []string{"Missing!A1:B2"} },
+			wantErr:   true,
+		},
+		{
+			name:      "invalid range",
+			configure: func(o *excelmetadata.Options) { o.Ranges = []string{"Data!A0"} },
+			wantErr:   true,
+		},
+		{
+			name:      "two ranges on one sheet",
+			configure: func(o *excelmetadata.Options) { o.Ranges = []string{"Data!A1:B2", "data!D4:E5"} },
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := excelmetadata.DefaultOptions()
+			tt.configure(options)
+			extractor, err := excelmetadata.New(filename, options)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			defer extractor.Close()
+
+			metadata, err := extractor.Extract()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := sheetNames(metadata); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("sheets = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	metadata := extractWithOptions(t, filename, func(o *excelmetadata.Options) { o.Sheets = []string{"Data"} })
+	if metadata.Sheets[0].Index != 5 {
+		t.Errorf("Data index = %d, want its workbook position 5", metadata.Sheets[0].Index)
+	}
+	if len(metadata.DefinedNames) != 1 || metadata.DefinedNames[0].Name != "Global" {
+		t.Errorf("defined names = %+v, want only the workbook scoped name", metadata.DefinedNames)
+	}
+
+	t.Run("ranges", func(t *testing.T) {
+		metadata := extractWithOptions(t, filename, func(o *excelmetadata.Options) {
+			o.Sheets = []string{"Data", "Q1"}
+			o.Ranges = []string{"Data!B2:D4"}
+		})
+
+		q1, data := metadata.Sheets[0], metadata.Sheets[1]
+		if len(q1.Cells) != 1 {
+			t.Errorf("Q1 has %d cells, want the whole sheet", len(q1.Cells))
+		}
+
+		var addresses []string
+		for _, cell := range data.Cells {
+			addresses = append(addresses, cell.Address)
+		}
+		// Merging B2:C3 clears C2, B3 and C3
+		want := []string{"B2", "D2", "D3", "B4", "C4", "D4"}
+		if !reflect.DeepEqual(addresses, want) {
+			t.Errorf("cells = %v, want %v", addresses, want)
+		}
+		if len(data.MergedCells) != 1 || data.MergedCells[0].StartCell != "B2" {
+			t.Errorf("merged cells = %+v, want only B2:C3", data.MergedCells)
+		}
+		if len(data.Comments) != 1 || data.Comments[0].Text != "inside" {
+			t.Errorf("comments = %+v, want only the B2 comment", data.Comments)
+		}
+		if len(data.DataValidations) != 0 {
+			t.Errorf("validations = %+v, want none outside the range", data.DataValidations)
+		}
+	})
+}
@@ -0,0 +1,357 @@
+package excelmetadata
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// SQLiteDriver is the database/sql driver name ExportSQLite opens. Both
+// modernc.org/sqlite and github.com/glebarez/go-sqlite register it without
+// cgo; import one of them for its side effects.
+const SQLiteDriver = "sqlite"
+
+// sqliteSchema is the normalised layout written by WriteSQLite. Every
+// statement is idempotent so databases can be appended to.
+var sqliteSchema = []string{
+	`CREATE TABLE IF NOT EXISTS workbooks (
+		id INTEGER PRIMARY KEY,
+		filename TEXT NOT NULL,
+		title TEXT,
+		subject TEXT,
+		creator TEXT,
+		keywords TEXT,
+		description TEXT,
+		last_modified_by TEXT,
+		category TEXT,
+		version TEXT,
+		created TEXT,
+		modified TEXT,
+		extracted_at TEXT
+	)`,
+	`CREATE TABLE IF NOT EXISTS sheets (
+		id INTEGER PRIMARY KEY,
+		workbook_id INTEGER NOT NULL REFERENCES workbooks(id) ON DELETE CASCADE,
+		sheet_index INTEGER NOT NULL,
+		name TEXT NOT NULL,
+		visible INTEGER NOT NULL,
+		start_cell TEXT,
+		end_cell TEXT,
+		row_count INTEGER,
+		col_count INTEGER,
+		protected INTEGER NOT NULL DEFAULT 0,
+		UNIQUE (workbook_id, name)
+	)`,
+	`CREATE TABLE IF NOT EXISTS styles (
+		id INTEGER PRIMARY KEY,
+		workbook_id INTEGER NOT NULL REFERENCES workbooks(id) ON DELETE CASCADE,
+		style_id INTEGER NOT NULL,
+		font_family TEXT,
+		font_size REAL,
+		font_color TEXT,
+		bold INTEGER NOT NULL DEFAULT 0,
+		italic INTEGER NOT NULL DEFAULT 0,
+		fill_color TEXT,
+		horizontal TEXT,
+		vertical TEXT,
+		wrap_text INTEGER NOT NULL DEFAULT 0,
+		number_format INTEGER,
+		summary TEXT,
+		details TEXT,
+		UNIQUE (workbook_id, style_id)
+	)`,
+	`CREATE TABLE IF NOT EXISTS cells (
+		id INTEGER PRIMARY KEY,
+		sheet_id INTEGER NOT NULL REFERENCES sheets(id) ON DELETE CASCADE,
+		address TEXT NOT NULL,
+		row_num INTEGER NOT NULL,
+		col_num INTEGER NOT NULL,
+		value TEXT,
+		raw_value TEXT,
+		formula TEXT,
+		type INTEGER,
+		style_id INTEGER,
+		hyperlink TEXT
+	)`,
+	`CREATE TABLE IF NOT EXISTS merged_ranges (
+		id INTEGER PRIMARY KEY,
+		sheet_id INTEGER NOT NULL REFERENCES sheets(id) ON DELETE CASCADE,
+		start_cell TEXT NOT NULL,
+		end_cell TEXT NOT NULL,
+		value TEXT
+	)`,
+	`CREATE TABLE IF NOT EXISTS validations (
+		id INTEGER PRIMARY KEY,
+		sheet_id INTEGER NOT NULL REFERENCES sheets(id) ON DELETE CASCADE,
+		cell_range TEXT NOT NULL,
+		type TEXT,
+		operator TEXT,
+		formula1 TEXT,
+		formula2 TEXT,
+		show_error INTEGER NOT NULL DEFAULT 0,
+		error_title TEXT,
+		error_message TEXT
+	)`,
+	`CREATE TABLE IF NOT EXISTS defined_names (
+		id INTEGER PRIMARY KEY,
+		workbook_id INTEGER NOT NULL REFERENCES workbooks(id) ON DELETE CASCADE,
+		name TEXT NOT NULL,
+		refers_to TEXT,
+		scope TEXT
+	)`,
+	`CREATE TABLE IF NOT EXISTS images (
+		id INTEGER PRIMARY KEY,
+		sheet_id INTEGER NOT NULL REFERENCES sheets(id) ON DELETE CASCADE,
+		cell TEXT NOT NULL,
+		extension TEXT,
+		alt_text TEXT,
+		size INTEGER,
+		data BLOB
+	)`,
+	`CREATE INDEX IF NOT EXISTS idx_sheets_workbook ON sheets (workbook_id)`,
+	`CREATE INDEX IF NOT EXISTS idx_cells_sheet_address ON cells (sheet_id, address)`,
+	`CREATE INDEX IF NOT EXISTS idx_cells_sheet_position ON cells (sheet_id, row_num, col_num)`,
+	`CREATE INDEX IF NOT EXISTS idx_cells_formula ON cells (formula) WHERE formula IS NOT NULL`,
+	`CREATE INDEX IF NOT EXISTS idx_merged_ranges_sheet ON merged_ranges (sheet_id)`,
+	`CREATE INDEX IF NOT EXISTS idx_validations_sheet ON validations (sheet_id)`,
+	`CREATE INDEX IF NOT EXISTS idx_defined_names_workbook ON defined_names (workbook_id, name)`,
+	`CREATE INDEX IF NOT EXISTS idx_images_sheet ON images (sheet_id)`,
+}
+
+// CreateSQLiteSchema creates the export tables and indexes when missing
+func CreateSQLiteSchema(db *sql.DB) error {
+	for _, stmt := range sqliteSchema {
+		if _, err := db.Exec(stmt); err != nil {
+			return fmt.Errorf("failed to create schema: %w", err)
+		}
+	}
+	return nil
+}
+
+// ExportSQLite appends every workbook to the SQLite database at path,
+// creating the file and schema when needed. A driver registered as
+// SQLiteDriver must be imported by the program.
+func ExportSQLite(path string, metadata ...*Metadata) error {
+	db, err := sql.Open(SQLiteDriver, path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	if err := CreateSQLiteSchema(db); err != nil {
+		return err
+	}
+	for _, meta := range metadata {
+		if _, err := WriteSQLite(db, meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteSQLite inserts one workbook into a database that already has the
+// schema, in a single transaction with foreign keys enforced, and returns
+// the id of its workbooks row. Cells join styles on the workbook and
+// style_id columns.
+func WriteSQLite(db *sql.DB, metadata *Metadata) (int64, error) {
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	// Foreign keys are a per-connection setting and cannot change inside
+	// a transaction
+	if _, err := conn.ExecContext(ctx, "PRAGMA foreign_keys = ON"); err != nil {
+		return 0, err
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	workbookID, err := insertWorkbook(ctx, tx, metadata)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, fmt.Errorf("failed to export %s: %w", metadata.Filename, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return workbookID, nil
+}
+
+func insertWorkbook(ctx context.Context, tx *sql.Tx, metadata *Metadata) (int64, error) {
+	props := metadata.Properties
+	res, err := tx.ExecContext(ctx, `INSERT INTO workbooks (filename, title, subject, creator, keywords,
+		description, last_modified_by, category, version, created, modified, extracted_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		metadata.Filename, nullString(props.Title), nullString(props.Subject), nullString(props.Creator),
+		nullString(props.Keywords), nullString(props.Description), nullString(props.LastModifiedBy),
+		nullString(props.Category), nullString(props.Version), nullString(props.Created),
+		nullString(props.Modified), metadata.ExtractedAt.UTC().Format("2006-01-02T15:04:05.999999999Z"))
+	if err != nil {
+		return 0, err
+	}
+	workbookID, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	if err := insertStyles(ctx, tx, workbookID, metadata.Styles); err != nil {
+		return 0, err
+	}
+
+	for _, dn := range metadata.DefinedNames {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO defined_names (workbook_id, name, refers_to, scope) VALUES (?, ?, ?, ?)`,
+			workbookID, dn.Name, dn.RefersTo, nullString(dn.Scope)); err != nil {
+			return 0, err
+		}
+	}
+
+	for _, sheet := range metadata.Sheets {
+		if err := insertSheet(ctx, tx, workbookID, sheet); err != nil {
+			return 0, fmt.Errorf("sheet %s: %w", sheet.Name, err)
+		}
+	}
+	return workbookID, nil
+}
+
+func insertStyles(ctx context.Context, tx *sql.Tx, workbookID int64, styles map[int]StyleDetails) error {
+	stmt, err := tx.PrepareContext(ctx, `INSERT INTO styles (workbook_id, style_id, font_family, font_size,
+		font_color, bold, italic, fill_color, horizontal, vertical, wrap_text, number_format, summary, details)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = stmt.Close()
+	}()
+
+	ids := make([]int, 0, len(styles))
+	for id := range styles {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	for _, id := range ids {
+		style := styles[id]
+		details, err := json.Marshal(style)
+		if err != nil {
+			return err
+		}
+
+		var (
+			family, fontColor, fillColor, horizontal, vertical interface{}
+			size                                               interface{}
+			bold, italic, wrap                                 bool
+		)
+		if f := style.Font; f != nil {
+			family, fontColor = nullString(f.Family), nullString(f.Color)
+			if f.Size > 0 {
+				size = f.Size
+			}
+			bold, italic = f.Bold, f.Italic
+		}
+		if style.Fill != nil && len(style.Fill.Color) > 0 {
+			fillColor = nullString(strings.Join(style.Fill.Color, ","))
+		}
+		if a := style.Alignment; a != nil {
+			horizontal, vertical, wrap = nullString(a.Horizontal), nullString(a.Vertical), a.WrapText
+		}
+
+		if _, err := stmt.ExecContext(ctx, workbookID, id, family, size, fontColor, bold, italic, fillColor,
+			horizontal, vertical, wrap, style.NumberFormat, StyleSummary(style), string(details)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertSheet(ctx context.Context, tx *sql.Tx, workbookID int64, sheet SheetMetadata) error {
+	protected := sheet.Protection != nil && sheet.Protection.Protected
+	res, err := tx.ExecContext(ctx, `INSERT INTO sheets (workbook_id, sheet_index, name, visible, start_cell,
+		end_cell, row_count, col_count, protected) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		workbookID, sheet.Index, sheet.Name, sheet.Visible, sheet.Dimensions.StartCell, sheet.Dimensions.EndCell,
+		sheet.Dimensions.RowCount, sheet.Dimensions.ColCount, protected)
+	if err != nil {
+		return err
+	}
+	sheetID, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	cellStmt, err := tx.PrepareContext(ctx, `INSERT INTO cells (sheet_id, address, row_num, col_num, value,
+		raw_value, formula, type, style_id, hyperlink) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = cellStmt.Close()
+	}()
+
+	for _, cell := range sheet.Cells {
+		col, row, err := excelize.CellNameToCoordinates(cell.Address)
+		if err != nil {
+			return err
+		}
+		var styleID, hyperlink interface{}
+		if cell.StyleID != 0 {
+			styleID = cell.StyleID
+		}
+		if cell.Hyperlink != nil {
+			hyperlink = cell.Hyperlink.Link
+		}
+		if _, err := cellStmt.ExecContext(ctx, sheetID, cell.Address, row, col, nullString(reportValue(cell.Value)),
+			nullString(cell.RawValue), nullString(cell.Formula), int(cell.Type), styleID, hyperlink); err != nil {
+			return err
+		}
+	}
+
+	for _, mc := range sheet.MergedCells {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO merged_ranges (sheet_id, start_cell, end_cell, value) VALUES (?, ?, ?, ?)`,
+			sheetID, mc.StartCell, mc.EndCell, nullString(mc.Value)); err != nil {
+			return err
+		}
+	}
+
+	for _, dv := range sheet.DataValidations {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO validations (sheet_id, cell_range, type, operator, formula1,
+			formula2, show_error, error_title, error_message) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			sheetID, dv.Range, nullString(dv.Type), nullString(dv.Operator), nullString(dv.Formula1),
+			nullString(dv.Formula2), dv.ShowError, dv.ErrorTitle, dv.ErrorMessage); err != nil {
+			return err
+		}
+	}
+
+	for _, img := range sheet.Images {
+		var altText interface{}
+		if img.Format != nil {
+			altText = nullString(img.Format.AltText)
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT INTO images (sheet_id, cell, extension, alt_text, size, data) VALUES (?, ?, ?, ?, ?, ?)`,
+			sheetID, img.Cell, img.Extension, altText, len(img.File), img.File); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nullString stores empty strings as NULL
+func nullString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
@@ -0,0 +1,92 @@
+package excelmetadata_test
+
+import (
+	"database/sql"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prongbang/excelmetadata"
+	"github.com/xuri/excelize/v2"
+	_ "modernc.org/sqlite"
+)
+
+func TestExportSQLite(t *testing.T) {
+	metadata := extract(t, newWorkbook(t, func(f *excelize.File) {
+		bold, _ := f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+		_ = f.SetCellValue("Sheet1", "A1", "Total")
+		_ = f.SetCellStyle("Sheet1", "A1", "A1", bold)
+		_ = f.SetCellValue("Sheet1", "B1", 3)
+		_ = f.SetCellFormula("Sheet1", "B1", "1+2")
+		_ = f.MergeCell("Sheet1", "C1", "D1")
+		_ = f.SetDefinedName(&excelize.DefinedName{Name: "Total", RefersTo: "Sheet1!$B$1"})
+
+		dv := excelize.NewDataValidation(true)
+		dv.Sqref = "B2:B5"
+		_ = dv.SetRange(1, 5, excelize.DataValidationTypeWhole, excelize.DataValidationOperatorBetween)
+		_ = f.AddDataValidation("Sheet1", dv)
+		_, _ = f.NewSheet("Notes")
+	}))
+
+	path := filepath.Join(t.TempDir(), "metadata.db")
+	if err := excelmetadata.ExportSQLite(path, metadata); err != nil {
+		t.Fatal(err)
+	}
+	// A second export appends instead of failing on the existing schema
+	if err := excelmetadata.ExportSQLite(path, metadata); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := sql.Open(excelmetadata.SQLiteDriver, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	// PRAGMA foreign_keys applies to a single connection
+	db.SetMaxOpenConns(1)
+
+	counts := map[string]int{
+		"workbooks":     2,
+		"sheets":        4,
+		"cells":         4,
+		"styles":        2,
+		"merged_ranges": 2,
+		"validations":   2,
+		"defined_names": 2,
+	}
+	for table, want := range counts {
+		var got int
+		if err := db.QueryRow("SELECT COUNT(*) FROM " + table).Scan(&got); err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("%s has %d rows, want %d", table, got, want)
+		}
+	}
+
+	var formula, summary string
+	if err := db.QueryRow(`SELECT c.formula FROM cells c
+		JOIN sheets sh ON sh.id = c.sheet_id
+		WHERE sh.workbook_id = 2 AND c.address = 'B1'`).Scan(&formula); err != nil {
+		t.Fatal(err)
+	}
+	if formula != "1+2" {
+		t.Errorf("formula = %q, want 1+2", formula)
+	}
+	if err := db.QueryRow(`SELECT s.summary FROM cells c
+		JOIN sheets sh ON sh.id = c.sheet_id
+		JOIN styles s ON s.workbook_id = sh.workbook_id AND s.style_id = c.style_id
+		WHERE sh.workbook_id = 1 AND c.address = 'A1'`).Scan(&summary); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(summary, "bold") {
+		t.Errorf("style summary = %q, want a bold font", summary)
+	}
+
+	if _, err := db.Exec("PRAGMA foreign_keys = ON"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("INSERT INTO sheets (workbook_id, sheet_index, name, visible) VALUES (99, 0, 'x', 1)"); err == nil {
+		t.Error("inserting a sheet of an unknown workbook should violate the foreign key")
+	}
+}
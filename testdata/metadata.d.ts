@@ -0,0 +1,274 @@
+// Code generated by excelmetadata. DO NOT EDIT.
+
+export interface Metadata {
+  filename: string;
+  properties: DocumentProperties;
+  sheets: SheetMetadata[] | null;
+  definedNames?: DefinedName[];
+  styles?: Record<string, StyleDetails>;
+  calculation?: CalculationReport;
+  security?: SecurityReport;
+  redactions?: RedactionReport;
+  extractedAt: string;
+}
+
+export interface DocumentProperties {
+  title?: string;
+  subject?: string;
+  creator?: string;
+  keywords?: string;
+  description?: string;
+  lastModifiedBy?: string;
+  category?: string;
+  version?: string;
+  created?: string;
+  modified?: string;
+}
+
+export interface SheetMetadata {
+  index: number;
+  name: string;
+  visible: boolean;
+  state?: string;
+  dimensions: SheetDimensions;
+  range?: string;
+  mergedCells?: MergedCell[];
+  tables?: TableMetadata[];
+  comments?: CommentMetadata[];
+  dataValidations?: DataValidation[];
+  protection?: SheetProtection;
+  rowHeights?: Record<string, number>;
+  colWidths?: Record<string, number>;
+  cells?: CellMetadata[];
+  totalNonEmptyCells?: number;
+  truncated?: boolean;
+  sampling?: string;
+  images?: ImageMetadata[];
+}
+
+export interface SheetDimensions {
+  startCell: string;
+  endCell: string;
+  rowCount: number;
+  colCount: number;
+  declaredRange?: string;
+  cellRange?: string;
+  mismatch?: string;
+}
+
+export interface MergedCell {
+  startCell: string;
+  endCell: string;
+  value?: string;
+}
+
+export interface TableMetadata {
+  name: string;
+  range: string;
+  styleName?: string;
+  showHeaderRow: boolean;
+}
+
+export interface CommentMetadata {
+  cell: string;
+  author?: string;
+  text: string;
+}
+
+export interface DataValidation {
+  range: string;
+  type: string;
+  operator?: string;
+  formula1?: string;
+  formula2?: string;
+  showError: boolean;
+  errorTitle?: string;
+  errorMessage?: string;
+}
+
+export interface SheetProtection {
+  protected: boolean;
+  password?: string;
+  editObjects: boolean;
+  editScenarios: boolean;
+  selectLockedCells: boolean;
+  selectUnlockedCells: boolean;
+}
+
+export interface CellMetadata {
+  address: string;
+  value?: unknown;
+  rawValue?: string;
+  formula?: string;
+  styleId?: number;
+  type: CellType;
+  hyperlink?: Hyperlink;
+  formulaInfo?: FormulaInfo;
+  functions?: string[];
+  references?: string[];
+}
+
+export type CellType = number;
+
+export interface Hyperlink {
+  link: string;
+}
+
+export interface FormulaInfo {
+  kind: string;
+  ref?: string;
+  sharedIndex?: number;
+  spillRange?: string;
+  cachedValue?: string;
+  cachedType?: string;
+}
+
+export interface ImageMetadata {
+  cell: string;
+  file: string | null;
+  extension: string;
+  insertType: number;
+  format: ImageFormat | null;
+}
+
+export interface ImageFormat {
+  AltText: string;
+  PrintObject: boolean | null;
+  Locked: boolean | null;
+  LockAspectRatio: boolean;
+  AutoFit: boolean;
+  AutoFitIgnoreAspect: boolean;
+  OffsetX: number;
+  OffsetY: number;
+  ScaleX: number;
+  ScaleY: number;
+  Hyperlink: string;
+  HyperlinkType: string;
+  Positioning: string;
+}
+
+export interface DefinedName {
+  name: string;
+  refersTo: string;
+  scope?: string;
+}
+
+export interface StyleDetails {
+  font?: FontStyle;
+  fill?: FillStyle;
+  border?: BorderStyle[];
+  alignment?: AlignmentStyle;
+  numberFormat?: number;
+  customNumberFormat?: string;
+  protection?: Protection;
+}
+
+export interface FontStyle {
+  bold?: boolean;
+  italic?: boolean;
+  underline?: string;
+  strike?: boolean;
+  family?: string;
+  size?: number;
+  color?: string;
+}
+
+export interface FillStyle {
+  type?: string;
+  pattern?: number;
+  color?: string[];
+}
+
+export interface BorderStyle {
+  type?: string;
+  color?: string;
+  style?: number;
+}
+
+export interface AlignmentStyle {
+  horizontal?: string;
+  vertical?: string;
+  wrapText?: boolean;
+  textRotation?: number;
+  indent?: number;
+  shrinkToFit?: boolean;
+}
+
+export interface Protection {
+  hidden?: boolean;
+  locked?: boolean;
+}
+
+export interface CalculationReport {
+  calcMode: string;
+  fullCalcOnLoad?: boolean;
+  checked: number;
+  stale?: CalcIssue[];
+  unevaluable?: CalcIssue[];
+}
+
+export interface CalcIssue {
+  sheet: string;
+  cell: string;
+  formula: string;
+  cached: string;
+  calculated?: string;
+  error?: string;
+}
+
+export interface SecurityReport {
+  vbaProject?: VBAProject;
+  externalLinks?: ExternalLink[];
+  ddeFormulas?: SecurityCell[];
+  oleObjects?: OLEObject[];
+  hyperlinks?: SecurityCell[];
+  hiddenSheets?: HiddenSheet[];
+}
+
+export interface VBAProject {
+  part: string;
+  modules?: string[];
+  signed: boolean;
+  error?: string;
+}
+
+export interface ExternalLink {
+  part: string;
+  kind: string;
+  target: string;
+  progId?: string;
+  items?: string[];
+}
+
+export interface SecurityCell {
+  sheet: string;
+  cell: string;
+  target: string;
+}
+
+export interface OLEObject {
+  sheet?: string;
+  progId?: string;
+  part?: string;
+  target?: string;
+  size?: number;
+  filename?: string;
+}
+
+export interface HiddenSheet {
+  name: string;
+  state: string;
+}
+
+export interface RedactionReport {
+  policy: string;
+  counts?: Record<string, number>;
+  redacted?: PIIFinding[];
+}
+
+export interface PIIFinding {
+  sheet?: string;
+  ref?: string;
+  field: string;
+  class: string;
+}
@@ -0,0 +1,216 @@
+package excelmetadata
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// TextLines renders metadata as deterministic, line-oriented text suitable
+// for line diffs, e.g. as a git textconv filter. The filename and extraction
+// timestamp are left out so the rendering depends only on workbook content.
+func TextLines(meta *Metadata) []string {
+	var lines []string
+	add := func(format string, args ...interface{}) {
+		lines = append(lines, fmt.Sprintf(format, args...))
+	}
+
+	for _, p := range propertyFields(meta.Properties) {
+		add("property %s = %s", p.name, strconv.Quote(p.value))
+	}
+
+	names := append([]DefinedName(nil), meta.DefinedNames...)
+	sort.SliceStable(names, func(i, j int) bool {
+		if names[i].Scope != names[j].Scope {
+			return names[i].Scope < names[j].Scope
+		}
+		return names[i].Name < names[j].Name
+	})
+	for _, dn := range names {
+		scope := dn.Scope
+		if scope == "" {
+			scope = "Workbook"
+		}
+		add("name %s!%s = %s", scope, dn.Name, dn.RefersTo)
+	}
+
+	for _, sheet := range meta.Sheets {
+		visibility := "visible"
+		if !sheet.Visible {
+			visibility = "hidden"
+		}
+		add("sheet %s (%s) %s:%s", sheet.Name, visibility, sheet.Dimensions.StartCell, sheet.Dimensions.EndCell)
+
+		if sheet.Protection != nil && sheet.Protection.Protected {
+			add("%s protected", sheet.Name)
+		}
+		if sheet.Truncated {
+			add("%s truncated %s %d of %d cells", sheet.Name, sheet.Sampling, len(sheet.Cells), sheet.TotalNonEmptyCells)
+		}
+		for _, row := range sortedIntKeys(sheet.RowHeights) {
+			add("%s row %d height %v", sheet.Name, row, sheet.RowHeights[row])
+		}
+		for _, col := range sortedColumnKeys(sheet.ColWidths) {
+			add("%s col %s width %v", sheet.Name, col, sheet.ColWidths[col])
+		}
+		for _, mc := range sheet.MergedCells {
+			add("%s!%s:%s merged", sheet.Name, mc.StartCell, mc.EndCell)
+		}
+		for _, tbl := range sheet.Tables {
+			add("%s!%s table %s", sheet.Name, tbl.Range, tbl.Name)
+		}
+		for _, c := range sheet.Comments {
+			add("%s!%s comment %s %s", sheet.Name, c.Cell, strconv.Quote(c.Author), strconv.Quote(c.Text))
+		}
+		for _, dv := range sheet.DataValidations {
+			line := fmt.Sprintf("%s!%s validation %s", sheet.Name, dv.Range, dv.Type)
+			if dv.Operator != "" {
+				line += " " + dv.Operator
+			}
+			if dv.Formula1 != "" {
+				line += " " + dv.Formula1
+			}
+			if dv.Formula2 != "" {
+				line += " " + dv.Formula2
+			}
+			lines = append(lines, line)
+		}
+		for _, cell := range sheet.Cells {
+			lines = append(lines, cellLine(sheet.Name, cell, meta.Styles))
+		}
+		for _, img := range sheet.Images {
+			add("%s!%s image %s (%d bytes)", sheet.Name, img.Cell, img.Extension, len(img.File))
+		}
+	}
+
+	return lines
+}
+
+// WriteText writes the TextLines rendering of meta to w
+func WriteText(w io.Writer, meta *Metadata) error {
+	bw := bufio.NewWriter(w)
+	for _, line := range TextLines(meta) {
+		if _, err := bw.WriteString(line + "\n"); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// StyleSummary returns a compact, single-line description of a style
+func StyleSummary(style StyleDetails) string {
+	var parts []string
+	if f := style.Font; f != nil {
+		font := strings.TrimSpace(fmt.Sprintf("%s %v", f.Family, f.Size))
+		if f.Size == 0 {
+			font = f.Family
+		}
+		if f.Bold {
+			font += " bold"
+		}
+		if f.Italic {
+			font += " italic"
+		}
+		if f.Underline != "" {
+			font += " underline"
+		}
+		if f.Strike {
+			font += " strike"
+		}
+		if f.Color != "" {
+			font += " " + f.Color
+		}
+		if font = strings.TrimSpace(font); font != "" {
+			parts = append(parts, "font="+font)
+		}
+	}
+	if style.Fill != nil && len(style.Fill.Color) > 0 {
+		parts = append(parts, "fill="+strings.Join(style.Fill.Color, ","))
+	}
+	if len(style.Border) > 0 {
+		var sides []string
+		for _, b := range style.Border {
+			sides = append(sides, b.Type)
+		}
+		parts = append(parts, "border="+strings.Join(sides, ","))
+	}
+	if a := style.Alignment; a != nil {
+		align := strings.Trim(a.Horizontal+","+a.Vertical, ",")
+		if a.WrapText {
+			align = strings.Trim(align+",wrap", ",")
+		}
+		if align != "" {
+			parts = append(parts, "align="+align)
+		}
+	}
+	if style.NumberFormat != 0 {
+		parts = append(parts, fmt.Sprintf("numfmt=%d", style.NumberFormat))
+	}
+	if p := style.Protection; p != nil && (p.Hidden || p.Locked) {
+		var flags []string
+		if p.Locked {
+			flags = append(flags, "locked")
+		}
+		if p.Hidden {
+			flags = append(flags, "hidden")
+		}
+		parts = append(parts, "protection="+strings.Join(flags, ","))
+	}
+	return strings.Join(parts, " ")
+}
+
+func cellLine(sheetName string, cell CellMetadata, styles map[int]StyleDetails) string {
+	line := fmt.Sprintf("%s!%s = %s", sheetName, cell.Address, textValue(cell.Value))
+	if cell.Formula != "" {
+		line += " formula " + strconv.Quote(cell.Formula)
+	}
+	if cell.Hyperlink != nil {
+		line += " link " + strconv.Quote(cell.Hyperlink.Link)
+	}
+	if cell.StyleID != 0 {
+		if style, ok := styles[cell.StyleID]; ok {
+			if summary := StyleSummary(style); summary != "" {
+				line += " [" + summary + "]"
+			}
+		}
+	}
+	return line
+}
+
+func textValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return `""`
+	case string:
+		return strconv.Quote(v)
+	default:
+		return strconv.Quote(fmt.Sprint(v))
+	}
+}
+
+func sortedIntKeys(m map[int]float64) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
+
+func sortedColumnKeys(m map[string]float64) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, _ := excelize.ColumnNameToNumber(keys[i])
+		b, _ := excelize.ColumnNameToNumber(keys[j])
+		return a < b
+	})
+	return keys
+}
@@ -0,0 +1,42 @@
+package excelmetadata_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/prongbang/excelmetadata"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestTextLines(t *testing.T) {
+	filename := newWorkbook(t, func(f *excelize.File) {
+		style, _ := f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+		_ = f.SetCellValue("Sheet1", "A1", "Name")
+		_ = f.SetCellStyle("Sheet1", "A1", "A1", style)
+		_ = f.SetCellValue("Sheet1", "B1", "docs")
+		_ = f.SetCellHyperLink("Sheet1", "B1", "https://example.com", "External")
+	})
+
+	first := excelmetadata.TextLines(extract(t, filename))
+	second := excelmetadata.TextLines(extract(t, filename))
+	if !reflect.DeepEqual(first, second) {
+		t.Fatalf("rendering is not deterministic:\n%v\n%v", first, second)
+	}
+
+	want := []string{
+		`Sheet1!A1 = "Name" [font=Calibri 11 bold]`,
+		`Sheet1!B1 = "docs" link "https://example.com"`,
+	}
+	for _, line := range want {
+		found := false
+		for _, got := range first {
+			if got == line {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("missing line %q in:\n%v", line, first)
+		}
+	}
+}
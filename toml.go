@@ -0,0 +1,180 @@
+package excelmetadata
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var bareTOMLKey = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// MarshalTOML renders metadata as TOML with the same field names and
+// ordering as the JSON output. TOML has no null, so null values are left out
+// of tables, and nulls in arrays, which would shift the indexes of the
+// following items, are an error.
+//
+// go-toml only reads toml struct tags and sorts map keys, so it would
+// rename every field and lose the JSON ordering. It is used by the tests to
+// check the output parses.
+func MarshalTOML(metadata *Metadata) ([]byte, error) {
+	tree, err := toOrderedTree(metadata)
+	if err != nil {
+		return nil, err
+	}
+	root, ok := tree.(orderedObject)
+	if !ok {
+		return nil, fmt.Errorf("metadata is not a TOML table")
+	}
+
+	var buf bytes.Buffer
+	if err := writeTOMLTable(&buf, nil, root); err != nil {
+		return nil, err
+	}
+	return bytes.TrimLeft(buf.Bytes(), "\n"), nil
+}
+
+// writeTOMLTable writes the body of the table at path: plain keys first,
+// then sub-tables and arrays of tables, which TOML requires to come last
+func writeTOMLTable(buf *bytes.Buffer, path []string, table orderedObject) error {
+	for _, m := range table {
+		if m.Value == nil || isTOMLTable(m.Value) || isTOMLTableArray(m.Value) {
+			continue
+		}
+		value, err := tomlInline(m.Value)
+		if err != nil {
+			return fmt.Errorf("%s: %w", tomlPath(append(append([]string(nil), path...), m.Key)), err)
+		}
+		fmt.Fprintf(buf, "%s = %s\n", tomlKey(m.Key), value)
+	}
+
+	for _, m := range table {
+		childPath := append(append([]string(nil), path...), m.Key)
+		switch {
+		case isTOMLTable(m.Value):
+			fmt.Fprintf(buf, "\n[%s]\n", tomlPath(childPath))
+			if err := writeTOMLTable(buf, childPath, m.Value.(orderedObject)); err != nil {
+				return err
+			}
+		case isTOMLTableArray(m.Value):
+			for _, item := range m.Value.([]interface{}) {
+				fmt.Fprintf(buf, "\n[[%s]]\n", tomlPath(childPath))
+				if err := writeTOMLTable(buf, childPath, item.(orderedObject)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func isTOMLTable(value interface{}) bool {
+	_, ok := value.(orderedObject)
+	return ok
+}
+
+// isTOMLTableArray reports whether value is a non-empty array of objects
+func isTOMLTableArray(value interface{}) bool {
+	arr, ok := value.([]interface{})
+	if !ok || len(arr) == 0 {
+		return false
+	}
+	for _, item := range arr {
+		if _, ok := item.(orderedObject); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// tomlInline renders a value on a single line
+func tomlInline(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case orderedObject:
+		parts := make([]string, 0, len(v))
+		for _, m := range v {
+			if m.Value == nil {
+				continue
+			}
+			part, err := tomlInline(m.Value)
+			if err != nil {
+				return "", fmt.Errorf("%s: %w", tomlKey(m.Key), err)
+			}
+			parts = append(parts, tomlKey(m.Key)+" = "+part)
+		}
+		if len(parts) == 0 {
+			return "{}", nil
+		}
+		return "{ " + strings.Join(parts, ", ") + " }", nil
+	case []interface{}:
+		parts := make([]string, 0, len(v))
+		for i, item := range v {
+			if item == nil {
+				return "", fmt.Errorf("TOML cannot represent the null at index %d", i)
+			}
+			part, err := tomlInline(item)
+			if err != nil {
+				return "", fmt.Errorf("index %d: %w", i, err)
+			}
+			parts = append(parts, part)
+		}
+		return "[" + strings.Join(parts, ", ") + "]", nil
+	case string:
+		return tomlString(v), nil
+	case json.Number:
+		return v.String(), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	default:
+		return `""`, nil
+	}
+}
+
+func tomlKey(key string) string {
+	if bareTOMLKey.MatchString(key) {
+		return key
+	}
+	return tomlString(key)
+}
+
+// tomlString renders s as a TOML basic string
+func tomlString(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			sb.WriteString(`\"`)
+		case '\\':
+			sb.WriteString(`\\`)
+		case '\b':
+			sb.WriteString(`\b`)
+		case '\t':
+			sb.WriteString(`\t`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\f':
+			sb.WriteString(`\f`)
+		case '\r':
+			sb.WriteString(`\r`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(&sb, `\u%04X`, r)
+			} else {
+				sb.WriteRune(r)
+			}
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+func tomlPath(path []string) string {
+	keys := make([]string, len(path))
+	for i, key := range path {
+		keys[i] = tomlKey(key)
+	}
+	return strings.Join(keys, ".")
+}
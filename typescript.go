@@ -0,0 +1,150 @@
+package excelmetadata
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// GenerateTypeScript emits TypeScript declarations for the JSON metadata
+// document. They are derived from the Go types by reflection, so field
+// names, optional members and nesting always match the JSON output.
+func GenerateTypeScript() []byte {
+	g := &tsGenerator{declared: map[reflect.Type]bool{}}
+	g.declare(reflect.TypeOf(Metadata{}))
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by excelmetadata. DO NOT EDIT.\n")
+	for _, decl := range g.decls {
+		buf.WriteString("\n")
+		buf.WriteString(decl)
+	}
+	return buf.Bytes()
+}
+
+type tsGenerator struct {
+	declared map[reflect.Type]bool
+	decls    []string
+}
+
+// declare emits a declaration for a named type, dependencies after it
+func (g *tsGenerator) declare(t reflect.Type) {
+	if g.declared[t] {
+		return
+	}
+	g.declared[t] = true
+
+	idx := len(g.decls)
+	g.decls = append(g.decls, "")
+
+	if t.Kind() != reflect.Struct {
+		g.decls[idx] = fmt.Sprintf("export type %s = %s;\n", t.Name(), g.primitive(t))
+		return
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "export interface %s {\n", t.Name())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, omitEmpty, skip := jsonFieldName(field)
+		if skip {
+			continue
+		}
+
+		tsType := g.typeOf(field.Type)
+		optional := ""
+		if omitEmpty {
+			optional = "?"
+		} else if nullable(field.Type) {
+			tsType += " | null"
+		}
+		fmt.Fprintf(&buf, "  %s%s: %s;\n", tsPropertyName(name), optional, tsType)
+	}
+	buf.WriteString("}\n")
+	g.decls[idx] = buf.String()
+}
+
+// typeOf returns the TypeScript spelling of t, declaring named types on the way
+func (g *tsGenerator) typeOf(t reflect.Type) string {
+	switch {
+	case t == timeType:
+		return "string"
+	case t.Kind() == reflect.Ptr:
+		return g.typeOf(t.Elem())
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		// encoding/json writes []byte as base64
+		return "string"
+	case t.Kind() == reflect.Slice:
+		elem := g.typeOf(t.Elem())
+		if strings.Contains(elem, " ") {
+			elem = "(" + elem + ")"
+		}
+		return elem + "[]"
+	case t.Kind() == reflect.Map:
+		return "Record<string, " + g.typeOf(t.Elem()) + ">"
+	case t.Kind() == reflect.Interface:
+		return "unknown"
+	case t.Name() != "" && t.PkgPath() != "":
+		g.declare(t)
+		return t.Name()
+	default:
+		return g.primitive(t)
+	}
+}
+
+func (g *tsGenerator) primitive(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	default:
+		return "unknown"
+	}
+}
+
+// jsonFieldName applies encoding/json naming rules to a struct field
+func jsonFieldName(field reflect.StructField) (name string, omitEmpty, skip bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false, true
+	}
+	parts := strings.Split(tag, ",")
+	name = parts[0]
+	if name == "" {
+		name = field.Name
+	}
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" || opt == "omitzero" {
+			omitEmpty = true
+		}
+	}
+	return name, omitEmpty, false
+}
+
+// nullable reports whether encoding/json may write null for a value of t
+func nullable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice:
+		return true
+	default:
+		return false
+	}
+}
+
+func tsPropertyName(name string) string {
+	for i, r := range name {
+		if !(r == '_' || r == '$' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (i > 0 && r >= '0' && r <= '9')) {
+			return fmt.Sprintf("%q", name)
+		}
+	}
+	return name
+}
@@ -0,0 +1,34 @@
+package excelmetadata_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prongbang/excelmetadata"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestGenerateTypeScriptGolden(t *testing.T) {
+	golden := filepath.Join("testdata", "metadata.d.ts")
+	got := excelmetadata.GenerateTypeScript()
+
+	if *update {
+		if err := os.MkdirAll("testdata", 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(golden, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("failed to read golden file (run go test -update): %v", err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("TypeScript declarations are out of date, run go test -run TypeScript -update\ngot:\n%s", got)
+	}
+}